@@ -26,15 +26,12 @@ var falseTime int32 = 0
 // Test the consistency performance at different read/write ratios
 func RequestRatio(cnum int, num int, servers []string, getRatio int, consistencyLevel int, quorum int) {
 	fmt.Printf("servers: %v\n", servers)
-	kvc := kvc.KVClient{
-		Kvservers:   make([]string, len(servers)),
-		Vectorclock: make(map[string]int32),
+	kvc, err := kvc.NewKVClient(servers, time.Minute)
+	if err != nil {
+		fmt.Printf("bootstrap from %v failed: %v\n", servers, err)
+		return
 	}
 	kvc.ConsistencyLevel = CAUSAL
-	for _, server := range servers {
-		kvc.Vectorclock[server+"1"] = 0
-	}
-	copy(kvc.Kvservers, servers)
 	start_time := time.Now()
 	for i := 0; i < num; i++ {
 		rand.Seed(time.Now().UnixNano())
@@ -84,14 +81,11 @@ func RequestRatio(cnum int, num int, servers []string, getRatio int, consistency
 */
 func benchmarkFromCSV(filepath string, servers []string, clientNumber int) {
 
-	kvc := kvc.KVClient{
-		Kvservers:   make([]string, len(servers)),
-		Vectorclock: make(map[string]int32),
-	}
-	for _, server := range servers {
-		kvc.Vectorclock[server+"1"] = 0
+	kvc, err := kvc.NewKVClient(servers, time.Minute)
+	if err != nil {
+		fmt.Printf("bootstrap from %v failed: %v\n", servers, err)
+		return
 	}
-	copy(kvc.Kvservers, servers)
 	writeCounts := util.ReadCsv(filepath)
 	start_time := time.Now()
 	for i := 0; i < len(writeCounts); i++ {
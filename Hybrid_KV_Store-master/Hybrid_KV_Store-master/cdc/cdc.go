@@ -0,0 +1,71 @@
+package cdc
+
+/*
+	变更数据捕获(CDC): 节点应用的每个写(本地客户端的写和其它节点同步过来的写)按应用的顺序追加到本地的分段日志
+	每条记录是一个Record的JSON，段文件的格式见seglog；go run ./cdc/dump 把记录读出为JSON lines
+*/
+
+import (
+	"encoding/json"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/seglog"
+)
+
+// Record is an applied mutation
+type Record struct {
+	// sequence number in the log of this node, set when reading
+	Seq uint64 `json:"seq,omitempty"`
+	// put, delete or expire (value is the time to live in seconds)
+	Op    string `json:"op"`
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+	// put: seconds to live of the value, 0 if it does not expire
+	TTL int `json:"ttl,omitempty"`
+	// internal address of the node which accepted the write, empty if unknown
+	Origin      string           `json:"origin"`
+	VectorClock map[string]int32 `json:"vector_clock"`
+	HLC         int64            `json:"hlc"`
+}
+
+type Writer struct {
+	log *seglog.Log
+}
+
+// Open continues the log in dir, segments roll at segmentBytes and the oldest beyond maxSegments are deleted
+func Open(dir string, segmentBytes int64, maxSegments int) (*Writer, error) {
+	log, err := seglog.Open(dir, seglog.Options{
+		SegmentBytes: segmentBytes,
+		Sync:         seglog.SyncBatch,
+		MaxSegments:  maxSegments,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{log: log}, nil
+}
+
+func (w *Writer) Append(r Record) error {
+	r.Seq = 0
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = w.log.Append(data)
+	return err
+}
+
+func (w *Writer) Close() error {
+	return w.log.Close()
+}
+
+// Read calls fn for every record in dir from the sequence number from, in order
+func Read(dir string, from uint64, fn func(Record) error) error {
+	return seglog.Read(dir, from, func(seq uint64, data []byte) error {
+		var r Record
+		if err := json.Unmarshal(data, &r); err != nil {
+			return err
+		}
+		r.Seq = seq
+		return fn(r)
+	})
+}
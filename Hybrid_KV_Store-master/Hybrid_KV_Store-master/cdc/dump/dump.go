@@ -0,0 +1,42 @@
+package main
+
+/*
+	把CDC日志读出为JSON lines，每行一个cdc.Record
+	go run ./cdc/dump -dir ./db/cdc -from 1 > changes.jsonl
+*/
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/cdc"
+)
+
+func main() {
+	var dir = flag.String("dir", "", "CDC directory of a node (-cdcDir of kvserver)")
+	var from = flag.Uint64("from", 0, "First sequence number to print")
+	var prefix = flag.String("prefix", "", "Only print the keys with the prefix")
+	flag.Parse()
+	if *dir == "" {
+		fmt.Println("### -dir is required")
+		os.Exit(2)
+	}
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+	err := cdc.Read(*dir, *from, func(r cdc.Record) error {
+		if !strings.HasPrefix(r.Key, *prefix) {
+			return nil
+		}
+		return enc.Encode(r)
+	})
+	if err != nil {
+		w.Flush()
+		fmt.Fprintln(os.Stderr, "### Read cdc failed:", err)
+		os.Exit(1)
+	}
+}
@@ -4,6 +4,17 @@ type Log struct {
 	Option string
 	Key    string
 	Value  string
+	// Put: seconds to live of the value, 0 if it does not expire; applied with the value, not as a separate Expire
+	TTL int `json:",omitempty"`
+	// Put with a TTL and Expire: unix time in seconds at which the key expires, only set in the write-ahead log,
+	// whose replay turns it back into the seconds left so a restart does not restart the countdown
+	ExpireAt int64 `json:",omitempty"`
+}
+
+// IsWrite reports whether the log changes the store and has to be replicated
+// Put, Delete, Expire(Value为秒数)
+func (l Log) IsWrite() bool {
+	return l.Option == "Put" || l.Option == "Delete" || l.Option == "Expire"
 }
 
 // Address for KV Service Between Server and Client
@@ -0,0 +1,55 @@
+package hlc
+
+/*
+	混合逻辑时钟(HLC)：时间戳的高48位是物理时间(毫秒)，低16位是逻辑计数
+	时间戳不小于本地物理时间，并且大于本节点见过的所有时间戳(本地写和其它节点同步过来的写)，因此与因果顺序一致
+*/
+
+import (
+	"sync"
+	"time"
+)
+
+const logicalBits = 16
+
+// Clock is safe for concurrent use, the zero value is ready
+type Clock struct {
+	mu   sync.Mutex
+	last int64
+}
+
+// Now returns a timestamp for a local event
+func (c *Clock) Now() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tick(0)
+}
+
+// Update returns a timestamp for the receipt of an event at remote
+func (c *Clock) Update(remote int64) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tick(remote)
+}
+
+func (c *Clock) tick(remote int64) int64 {
+	ts := FromTime(time.Now()) &^ (1<<logicalBits - 1)
+	if c.last >= ts {
+		ts = c.last + 1
+	}
+	if remote >= ts {
+		ts = remote + 1
+	}
+	c.last = ts
+	return ts
+}
+
+// FromTime returns the largest timestamp at the millisecond of t
+func FromTime(t time.Time) int64 {
+	return t.UnixMilli()<<logicalBits | (1<<logicalBits - 1)
+}
+
+// Time returns the physical time of ts
+func Time(ts int64) time.Time {
+	return time.UnixMilli(ts >> logicalBits)
+}
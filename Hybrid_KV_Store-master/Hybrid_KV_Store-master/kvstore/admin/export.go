@@ -0,0 +1,351 @@
+package main
+
+/*
+	export/import: 以JSON lines或CSV导出和导入key和value，可选带上版本(vectorclock)和HLC时间戳
+	export按key的顺序扫描(ScanInCausal)，import通过客户端的Put按选择的一致性写入，会同步给其它节点，-rate限制每秒写入的个数
+	CSV的第一行是列名: key,value[,version,hlc]，version是vectorclock的JSON
+*/
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/kvstore/client"
+)
+
+const (
+	formatJSONL = "jsonl"
+	formatCSV   = "csv"
+)
+
+// record is a line of an export
+type record struct {
+	Key     string           `json:"key"`
+	Value   string           `json:"value"`
+	Version map[string]int32 `json:"version,omitempty"`
+	HLC     int64            `json:"hlc,omitempty"`
+}
+
+// parsePrefixes splits comma separated prefixes, a prefix covered by a shorter one is dropped
+func parsePrefixes(s string) []string {
+	if s == "" {
+		return []string{""}
+	}
+	all := strings.Split(s, ",")
+	sort.Strings(all)
+	prefixes := make([]string, 0, len(all))
+	for _, prefix := range all {
+		if len(prefixes) > 0 && strings.HasPrefix(prefix, prefixes[len(prefixes)-1]) {
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes
+}
+
+func hasAnyPrefix(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatOf returns the format of the flag, or of the extension of path
+func formatOf(format string, path string) (string, error) {
+	if format == "" {
+		format = formatJSONL
+		if strings.HasSuffix(path, ".csv") {
+			format = formatCSV
+		}
+	}
+	if format != formatJSONL && format != formatCSV {
+		return "", fmt.Errorf("unknown format %q, jsonl or csv", format)
+	}
+	return format, nil
+}
+
+type recordWriter interface {
+	write(r record) error
+	flush() error
+}
+
+type jsonlWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func (w *jsonlWriter) write(r record) error {
+	return w.enc.Encode(r)
+}
+
+func (w *jsonlWriter) flush() error {
+	return w.w.Flush()
+}
+
+type csvWriter struct {
+	w        *csv.Writer
+	metadata bool
+}
+
+func (w *csvWriter) write(r record) error {
+	if !w.metadata {
+		return w.w.Write([]string{r.Key, r.Value})
+	}
+	version, _ := json.Marshal(r.Version)
+	return w.w.Write([]string{r.Key, r.Value, string(version), strconv.FormatInt(r.HLC, 10)})
+}
+
+func (w *csvWriter) flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func exportCommand(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var address = fs.String("address", "127.0.0.1:3088", "kvrpc address of a node")
+	var prefix = fs.String("prefix", "", "Comma separated key prefixes, empty exports all keys")
+	var format = fs.String("format", "", "jsonl or csv, by the extension of -out if empty")
+	var metadata = fs.Bool("metadata", false, "Export the version (vectorclock) and the HLC timestamp of every key")
+	var out = fs.String("out", "", "Output file, stdout if empty")
+	var pageSize = fs.Int("pageSize", 1000, "Keys read per scan")
+	fs.Parse(args)
+	outFormat, err := formatOf(*format, *out)
+	if err != nil {
+		return err
+	}
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	var rw recordWriter
+	if outFormat == formatCSV {
+		cw := &csvWriter{w: csv.NewWriter(w), metadata: *metadata}
+		header := []string{"key", "value"}
+		if *metadata {
+			header = append(header, "version", "hlc")
+		}
+		if err := cw.w.Write(header); err != nil {
+			return err
+		}
+		rw = cw
+	} else {
+		bw := bufio.NewWriter(w)
+		rw = &jsonlWriter{w: bw, enc: json.NewEncoder(bw)}
+	}
+	c, err := client.New(client.Options{Seeds: []string{*address}, RefreshInterval: -1})
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	ctx := context.Background()
+	exported := 0
+	for _, p := range parsePrefixes(*prefix) {
+		token := ""
+		for {
+			entries, next, err := c.ScanPrefix(ctx, p, *pageSize, token)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				r := record{Key: e.Key, Value: e.Value}
+				if *metadata {
+					r.Version = e.Version
+					r.HLC = e.HLC
+				}
+				if err := rw.write(r); err != nil {
+					return err
+				}
+				exported++
+			}
+			if next == "" {
+				break
+			}
+			token = next
+		}
+	}
+	if err := rw.flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "exported", exported, "keys")
+	return nil
+}
+
+// recordReader returns io.EOF after the last record
+type recordReader func() (record, error)
+
+func newJSONLReader(r io.Reader) recordReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	line := 0
+	return func() (record, error) {
+		for scanner.Scan() {
+			line++
+			if len(strings.TrimSpace(scanner.Text())) == 0 {
+				continue
+			}
+			var rec record
+			if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+				return rec, fmt.Errorf("line %d: %w", line, err)
+			}
+			return rec, nil
+		}
+		if err := scanner.Err(); err != nil {
+			return record{}, err
+		}
+		return record{}, io.EOF
+	}
+}
+
+func newCSVReader(r io.Reader) (recordReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("csv header: %w", err)
+	}
+	keyColumn, valueColumn := -1, -1
+	for i, name := range header {
+		switch strings.TrimSpace(name) {
+		case "key":
+			keyColumn = i
+		case "value":
+			valueColumn = i
+		}
+	}
+	if keyColumn < 0 || valueColumn < 0 {
+		return nil, errors.New("csv header has no key or value column")
+	}
+	return func() (record, error) {
+		row, err := cr.Read()
+		if err != nil {
+			return record{}, err
+		}
+		if keyColumn >= len(row) || valueColumn >= len(row) {
+			line, _ := cr.FieldPos(0)
+			return record{}, fmt.Errorf("line %d: missing columns", line)
+		}
+		return record{Key: row[keyColumn], Value: row[valueColumn]}, nil
+	}, nil
+}
+
+// pacer spaces the calls of wait by the interval of rate per second, 0 means no limit
+type pacer struct {
+	interval time.Duration
+	next     time.Time
+}
+
+func newPacer(rate float64) *pacer {
+	p := &pacer{}
+	if rate > 0 {
+		p.interval = time.Duration(float64(time.Second) / rate)
+	}
+	return p
+}
+
+func (p *pacer) wait() {
+	if p.interval == 0 {
+		return
+	}
+	now := time.Now()
+	if p.next.After(now) {
+		time.Sleep(p.next.Sub(now))
+	} else {
+		p.next = now
+	}
+	p.next = p.next.Add(p.interval)
+}
+
+func parseConsistency(s string) (client.Consistency, error) {
+	switch s {
+	case "causal":
+		return client.Causal, nil
+	case "writeless-causal":
+		return client.WritelessCausal, nil
+	}
+	return client.Causal, fmt.Errorf("unknown consistency %q, causal or writeless-causal", s)
+}
+
+func importCommand(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	var address = fs.String("address", "127.0.0.1:3088", "kvrpc address of a node")
+	var in = fs.String("in", "", "Input file, stdin if empty")
+	var format = fs.String("format", "", "jsonl or csv, by the extension of -in if empty")
+	var prefix = fs.String("prefix", "", "Comma separated key prefixes, empty imports all keys")
+	var consistency = fs.String("consistency", "causal", "Consistency of the puts: causal or writeless-causal")
+	var rate = fs.Float64("rate", 0, "Max puts per second, 0 means no limit")
+	fs.Parse(args)
+	inFormat, err := formatOf(*format, *in)
+	if err != nil {
+		return err
+	}
+	level, err := parseConsistency(*consistency)
+	if err != nil {
+		return err
+	}
+	var r io.Reader = os.Stdin
+	if *in != "" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	var next recordReader
+	if inFormat == formatCSV {
+		next, err = newCSVReader(r)
+		if err != nil {
+			return err
+		}
+	} else {
+		next = newJSONLReader(r)
+	}
+	c, err := client.New(client.Options{Seeds: []string{*address}, Consistency: level})
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	prefixes := parsePrefixes(*prefix)
+	p := newPacer(*rate)
+	ctx := context.Background()
+	imported, skipped := 0, 0
+	start := time.Now()
+	for {
+		rec, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("after %d keys: %w", imported, err)
+		}
+		if !hasAnyPrefix(rec.Key, prefixes) {
+			skipped++
+			continue
+		}
+		p.wait()
+		if err := c.Put(ctx, rec.Key, rec.Value); err != nil {
+			return fmt.Errorf("put %s after %d keys: %w", rec.Key, imported, err)
+		}
+		imported++
+	}
+	fmt.Fprintf(os.Stderr, "imported %d keys, skipped %d, in %v\n", imported, skipped, time.Since(start).Round(time.Millisecond))
+	return nil
+}
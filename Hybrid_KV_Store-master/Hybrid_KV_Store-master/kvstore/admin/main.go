@@ -0,0 +1,190 @@
+package main
+
+/*
+	节点管理命令，通过kvrpc地址上的ADMIN服务；export和import通过客户端的Scan和Put，见export.go
+	go run ./kvstore/admin frontier -address 192.168.10.120:3088
+	go run ./kvstore/admin snapshot -address 192.168.10.120:3088 -out node1.snap
+	go run ./kvstore/admin restore -address 192.168.10.121:3088 -in node1.snap
+	go run ./kvstore/admin copy -from 192.168.10.120:3088 -to 192.168.10.121:3088
+	go run ./kvstore/admin export -address 192.168.10.120:3088 -prefix user/ -format csv -out users.csv
+	go run ./kvstore/admin import -address 192.168.10.120:3088 -in users.csv -consistency causal -rate 500
+*/
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/adminrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var commands = map[string]func(args []string) error{
+	"frontier": frontier,
+	"snapshot": snapshotCommand,
+	"restore":  restore,
+	"copy":     copyCommand,
+	"export":   exportCommand,
+	"import":   importCommand,
+}
+
+func main() {
+	if len(os.Args) < 2 || commands[os.Args[1]] == nil {
+		names := make([]string, 0, len(commands))
+		for name := range commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Println("usage: admin <command> [flags], commands:", names)
+		os.Exit(2)
+	}
+	if err := commands[os.Args[1]](os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, "###", os.Args[1], "failed:", err)
+		os.Exit(1)
+	}
+}
+
+func dial(address string) (adminrpc.ADMINClient, *grpc.ClientConn, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return adminrpc.NewADMINClient(conn), conn, nil
+}
+
+func frontier(args []string) error {
+	fs := flag.NewFlagSet("frontier", flag.ExitOnError)
+	var address = fs.String("address", "127.0.0.1:3088", "kvrpc address of the node")
+	fs.Parse(args)
+	client, conn, err := dial(*address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	reply, err := client.GetStableFrontier(ctx, &adminrpc.GetStableFrontierRequest{})
+	if err != nil {
+		return err
+	}
+	fmt.Println("complete:", reply.Complete)
+	fmt.Println("frontier:", reply.Frontier)
+	for _, clock := range reply.Clocks {
+		updated := "never"
+		if clock.Updated != 0 {
+			updated = time.UnixMilli(clock.Updated).Format(time.RFC3339Nano)
+		}
+		fmt.Println(clock.Node, clock.Vectorclock, updated)
+	}
+	return nil
+}
+
+func snapshotCommand(args []string) error {
+	fs := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	var address = fs.String("address", "127.0.0.1:3088", "kvrpc address of the node")
+	var out = fs.String("out", "", "Snapshot file, stdout if empty")
+	fs.Parse(args)
+	client, conn, err := dial(*address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := client.Snapshot(context.Background(), &adminrpc.SnapshotRequest{})
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = io.Copy(os.Stdout, adminrpc.SnapshotReader(stream))
+		return err
+	}
+	// a failed snapshot does not replace an existing file
+	tmp := *out + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(f, adminrpc.SnapshotReader(stream))
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, *out); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%s: %d bytes\n", *out, n)
+	return nil
+}
+
+// restoreFrom sends the snapshot read from r to the node behind client
+func restoreFrom(client adminrpc.ADMINClient, r io.Reader) error {
+	stream, err := client.Restore(context.Background())
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyBuffer(adminrpc.RestoreWriter(stream), r, make([]byte, 64*1024)); err != nil && err != io.EOF {
+		stream.CloseSend()
+		return err
+	}
+	reply, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "restored", reply.Keys, "keys, vectorclock", reply.Vectorclock)
+	return nil
+}
+
+func restore(args []string) error {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	var address = fs.String("address", "127.0.0.1:3088", "kvrpc address of the empty node")
+	var in = fs.String("in", "", "Snapshot file, stdin if empty")
+	fs.Parse(args)
+	var r io.Reader = os.Stdin
+	if *in != "" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	client, conn, err := dial(*address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return restoreFrom(client, r)
+}
+
+// copyCommand streams the snapshot of a node into an empty node without a file
+func copyCommand(args []string) error {
+	fs := flag.NewFlagSet("copy", flag.ExitOnError)
+	var from = fs.String("from", "", "kvrpc address of the source node")
+	var to = fs.String("to", "", "kvrpc address of the empty node")
+	fs.Parse(args)
+	source, sourceConn, err := dial(*from)
+	if err != nil {
+		return err
+	}
+	defer sourceConn.Close()
+	target, targetConn, err := dial(*to)
+	if err != nil {
+		return err
+	}
+	defer targetConn.Close()
+	stream, err := source.Snapshot(context.Background(), &adminrpc.SnapshotRequest{})
+	if err != nil {
+		return err
+	}
+	return restoreFrom(target, adminrpc.SnapshotReader(stream))
+}
@@ -0,0 +1,474 @@
+package client
+
+/*
+	kvstore的Go客户端库，可以被多个goroutine同时使用
+	所有请求都带context，失败时返回 ErrStale、ErrUnavailable、ErrTimeout(可用errors.Is判断)，重试次数有限
+
+	c, err := client.New(client.Options{Seeds: []string{"192.168.10.120:3088"}})
+	err = c.Put(ctx, "key", "value")
+	v, err := c.Get(ctx, "key", client.WithConsistency(client.WritelessCausal))
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// status of a node in GetClusterInfo
+const nodeStatusUp = "up"
+
+type Client struct {
+	opts Options
+	mu   sync.Mutex
+	// kvrpc addresses of the nodes which are up, requests start at nodes[next]
+	nodes []string
+	next  int
+	conns map[string]*grpc.ClientConn
+	// causal context: the merge of the vectorclocks of all responses,
+	// session is its token, empty if no single response carried the merged clock
+	vectorclock map[string]int32
+	session     string
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+// reply of one attempt
+type reply struct {
+	value        string
+	values       []string
+	success      bool
+	vectorclock  map[string]int32
+	session      string
+	laggingNodes []string
+	// snapshot of a read-only transaction
+	snapshot map[string]int32
+}
+
+// attempt sends one request with the causal context, session wins over vc if set
+type attempt func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error)
+
+// New fetches the topology from the seeds
+func New(opts Options) (*Client, error) {
+	if len(opts.Seeds) == 0 {
+		return nil, errors.New("client: no seed address")
+	}
+	if opts.Retry.MaxAttempts == 0 {
+		opts.Retry = DefaultRetryPolicy
+	}
+	if opts.RequestTimeout <= 0 {
+		opts.RequestTimeout = 2 * time.Second
+	}
+	if opts.RefreshInterval == 0 {
+		opts.RefreshInterval = time.Minute
+	}
+	c := &Client{
+		opts:        opts,
+		conns:       make(map[string]*grpc.ClientConn),
+		vectorclock: make(map[string]int32),
+		closed:      make(chan struct{}),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), opts.RequestTimeout)
+	defer cancel()
+	if err := c.Refresh(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if opts.RefreshInterval > 0 {
+		go c.refreshLoop()
+	}
+	return c, nil
+}
+
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, conn := range c.conns {
+			conn.Close()
+		}
+		c.conns = make(map[string]*grpc.ClientConn)
+	})
+	return nil
+}
+
+// conn returns the shared connection to address, grpc reconnects it when needed
+func (c *Client) conn(address string) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if conn, ok := c.conns[address]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	c.conns[address] = conn
+	return conn, nil
+}
+
+// Refresh asks the known nodes first, then the seeds, for the topology
+func (c *Client) Refresh(ctx context.Context) error {
+	c.mu.Lock()
+	candidates := append(append([]string{}, c.nodes...), c.opts.Seeds...)
+	c.mu.Unlock()
+	var lastErr error
+	for _, address := range candidates {
+		conn, err := c.conn(address)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		actx, cancel := context.WithTimeout(ctx, c.opts.RequestTimeout)
+		info, err := kvrpc.NewKVClient(conn).GetClusterInfo(actx, &kvrpc.GetClusterInfoRequest{})
+		cancel()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		nodes := make([]string, 0, len(info.Nodes))
+		for _, node := range info.Nodes {
+			if node.Status == nodeStatusUp && node.Address != "" {
+				nodes = append(nodes, node.Address)
+			}
+		}
+		if len(nodes) == 0 {
+			lastErr = errors.New("no node is up")
+			continue
+		}
+		c.mu.Lock()
+		current := ""
+		if len(c.nodes) > 0 {
+			current = c.nodes[c.next%len(c.nodes)]
+		}
+		c.nodes = nodes
+		c.next = 0
+		for i, node := range nodes {
+			if node == current {
+				c.next = i
+			}
+		}
+		c.mu.Unlock()
+		return nil
+	}
+	return fmt.Errorf("%w: fetch topology from %s: %v", ErrUnavailable, strings.Join(candidates, ","), lastErr)
+}
+
+func (c *Client) refreshLoop() {
+	ticker := time.NewTicker(c.opts.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closed:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), c.opts.RequestTimeout)
+			c.Refresh(ctx)
+			cancel()
+		}
+	}
+}
+
+// pick returns the node of the next attempt
+func (c *Client) pick() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.nodes) == 0 {
+		return "", fmt.Errorf("%w: no node", ErrUnavailable)
+	}
+	return c.nodes[c.next%len(c.nodes)], nil
+}
+
+// skip moves the following requests off address
+func (c *Client) skip(address string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.nodes) > 0 && c.nodes[c.next%len(c.nodes)] == address {
+		c.next = (c.next + 1) % len(c.nodes)
+	}
+}
+
+// causalContext returns the session token, or a copy of the vectorclock if there is no token
+func (c *Client) causalContext() (map[string]int32, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session != "" {
+		return nil, c.session
+	}
+	vc := make(map[string]int32, len(c.vectorclock))
+	for id, counter := range c.vectorclock {
+		vc[id] = counter
+	}
+	return vc, ""
+}
+
+// observe merges the vectorclock of a response, its token is kept only if it covers the merged clock
+func (c *Client) observe(vc map[string]int32, session string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	covers := true
+	for id, counter := range c.vectorclock {
+		if vc[id] < counter {
+			covers = false
+			break
+		}
+	}
+	for id, counter := range vc {
+		if counter > c.vectorclock[id] {
+			c.vectorclock[id] = counter
+		}
+	}
+	if covers {
+		c.session = session
+	} else {
+		c.session = ""
+	}
+}
+
+func (c *Client) dropSession() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.session = ""
+}
+
+func contextError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %v", ErrTimeout, err)
+	}
+	return err
+}
+
+// do runs fn on one node after another until it succeeds or the retry policy gives up
+func (c *Client) do(ctx context.Context, o *callOptions, fn attempt) (*reply, error) {
+	maxAttempts := o.retry.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	var lastErr error
+	for i := 0; i < maxAttempts; i++ {
+		if i > 0 {
+			timer := time.NewTimer(o.retry.backoff(i))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, contextError(ctx.Err())
+			case <-timer.C:
+			}
+		}
+		address, err := c.pick()
+		if err != nil {
+			return nil, err
+		}
+		conn, err := c.conn(address)
+		if err != nil {
+			lastErr = fmt.Errorf("%w: %s: %v", ErrUnavailable, address, err)
+			c.skip(address)
+			continue
+		}
+		vc, session := c.causalContext()
+		actx, cancel := context.WithTimeout(ctx, c.opts.RequestTimeout)
+		res, err := fn(actx, kvrpc.NewKVClient(conn), vc, session)
+		cancel()
+		if errors.Is(err, ErrSnapshotTooOld) {
+			return nil, err
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, contextError(ctx.Err())
+			}
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				// the token is not accepted (e.g. the peers changed), fall back to the vectorclock
+				c.dropSession()
+				lastErr = fmt.Errorf("%w: %s: %v", ErrUnavailable, address, err)
+			case codes.DeadlineExceeded:
+				lastErr = fmt.Errorf("%w: %s: %v", ErrTimeout, address, err)
+				c.skip(address)
+			default:
+				lastErr = fmt.Errorf("%w: %s: %v", ErrUnavailable, address, err)
+				c.skip(address)
+			}
+			continue
+		}
+		if !res.success {
+			lastErr = fmt.Errorf("%w: %s has not caught up with the causal context", ErrStale, address)
+			c.skip(address)
+			continue
+		}
+		c.observe(res.vectorclock, res.session)
+		return res, nil
+	}
+	return nil, lastErr
+}
+
+func (c *Client) callOptions(opts []Option) *callOptions {
+	o := &callOptions{
+		consistency: c.opts.Consistency,
+		retry:       c.opts.Retry,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Get returns "" for a missing key. A writeless read which could not reach every replica
+// returns the value together with ErrStale, unless WithAllowStale is given
+func (c *Client) Get(ctx context.Context, key string, opts ...Option) (string, error) {
+	o := c.callOptions(opts)
+	res, err := c.do(ctx, o, func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		switch o.consistency {
+		case WritelessCausal:
+			r, err := kv.GetInWritelessCausal(ctx, &kvrpc.GetInWritelessCausalRequest{Key: key, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{value: r.Value, success: r.Success, vectorclock: r.Vectorclock, session: r.Session, laggingNodes: r.LaggingNodes}, nil
+		default:
+			r, err := kv.GetInCausal(ctx, &kvrpc.GetInCausalRequest{Key: key, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{value: r.Value, success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(res.laggingNodes) > 0 && !o.allowStale {
+		return res.value, fmt.Errorf("%w: replicas %s did not answer", ErrStale, strings.Join(res.laggingNodes, ","))
+	}
+	return res.value, nil
+}
+
+func (c *Client) Put(ctx context.Context, key string, value string, opts ...Option) error {
+	o := c.callOptions(opts)
+	_, err := c.do(ctx, o, func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		switch o.consistency {
+		case WritelessCausal:
+			r, err := kv.PutInWritelessCausal(ctx, &kvrpc.PutInWritelessCausalRequest{Key: key, Value: value, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		default:
+			r, err := kv.PutInCausal(ctx, &kvrpc.PutInCausalRequest{Key: key, Value: value, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		}
+	})
+	return err
+}
+
+func (c *Client) Delete(ctx context.Context, key string, opts ...Option) error {
+	o := c.callOptions(opts)
+	_, err := c.do(ctx, o, func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		switch o.consistency {
+		case WritelessCausal:
+			r, err := kv.DeleteInWritelessCausal(ctx, &kvrpc.DeleteInWritelessCausalRequest{Key: key, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		default:
+			r, err := kv.DeleteInCausal(ctx, &kvrpc.DeleteInCausalRequest{Key: key, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		}
+	})
+	return err
+}
+
+// MultiGet reads all keys from one causal snapshot, the values are in the order of keys, "" for a missing key
+func (c *Client) MultiGet(ctx context.Context, keys []string, opts ...Option) ([]string, error) {
+	o := c.callOptions(opts)
+	res, err := c.do(ctx, o, func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		switch o.consistency {
+		case WritelessCausal:
+			r, err := kv.MultiGetInWritelessCausal(ctx, &kvrpc.MultiGetInWritelessCausalRequest{Keys: keys, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{values: pairValues(r.Pairs), success: r.Success, vectorclock: r.Vectorclock, session: r.Session, laggingNodes: r.LaggingNodes}, nil
+		default:
+			r, err := kv.MultiGetInCausal(ctx, &kvrpc.MultiGetInCausalRequest{Keys: keys, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{values: pairValues(r.Pairs), success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.laggingNodes) > 0 && !o.allowStale {
+		return res.values, fmt.Errorf("%w: replicas %s did not answer", ErrStale, strings.Join(res.laggingNodes, ","))
+	}
+	return res.values, nil
+}
+
+// MultiPut writes all pairs as one batch with a single vectorclock increment
+func (c *Client) MultiPut(ctx context.Context, pairs map[string]string, opts ...Option) error {
+	o := c.callOptions(opts)
+	kvPairs := make([]*kvrpc.KeyValue, 0, len(pairs))
+	for key, value := range pairs {
+		kvPairs = append(kvPairs, &kvrpc.KeyValue{Key: key, Value: value})
+	}
+	_, err := c.do(ctx, o, func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		switch o.consistency {
+		case WritelessCausal:
+			r, err := kv.MultiPutInWritelessCausal(ctx, &kvrpc.MultiPutInWritelessCausalRequest{Pairs: kvPairs, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		default:
+			r, err := kv.MultiPutInCausal(ctx, &kvrpc.MultiPutInCausalRequest{Pairs: kvPairs, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+			if err != nil {
+				return nil, err
+			}
+			return &reply{success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+		}
+	})
+	return err
+}
+
+// WriteTxn writes the pairs and deletes the keys as one transaction: every node shows all or none of its writes.
+// The deletes are applied after the puts
+func (c *Client) WriteTxn(ctx context.Context, pairs map[string]string, deletes ...string) error {
+	o := c.callOptions(nil)
+	kvPairs := make([]*kvrpc.KeyValue, 0, len(pairs))
+	for key, value := range pairs {
+		kvPairs = append(kvPairs, &kvrpc.KeyValue{Key: key, Value: value})
+	}
+	_, err := c.do(ctx, o, func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		r, err := kv.WriteTxnInCausal(ctx, &kvrpc.WriteTxnInCausalRequest{Pairs: kvPairs, Deletes: deletes, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+		if err != nil {
+			return nil, err
+		}
+		return &reply{success: r.Success, vectorclock: r.Vectorclock, session: r.Session}, nil
+	})
+	return err
+}
+
+func pairValues(pairs []*kvrpc.KeyValue) []string {
+	values := make([]string, len(pairs))
+	for i, pair := range pairs {
+		values[i] = pair.Value
+	}
+	return values
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"errors"
+	"time"
+)
+
+type Consistency int
+
+const (
+	Causal Consistency = iota
+	WritelessCausal
+)
+
+func (c Consistency) String() string {
+	switch c {
+	case Causal:
+		return "causal"
+	case WritelessCausal:
+		return "writeless-causal"
+	}
+	return "unknown"
+}
+
+var (
+	// no node has caught up with the causal context of the client, or a writeless read
+	// could not pull the deferred puts from every replica (the value is returned with the error)
+	ErrStale = errors.New("client: stale")
+	// every attempt failed to reach a node
+	ErrUnavailable = errors.New("client: unavailable")
+	// the context or the per-attempt timeout expired
+	ErrTimeout = errors.New("client: timeout")
+	// the versions read by a read-only transaction have been discarded, begin a new one
+	ErrSnapshotTooOld = errors.New("client: snapshot too old")
+	// a watch could not resume, the events after its clock have been discarded; read again and watch
+	ErrWatchGap = errors.New("client: watch gap")
+)
+
+// RetryPolicy retries on another node after InitialBackoff, multiplied by Multiplier up to MaxBackoff
+type RetryPolicy struct {
+	// attempts including the first one, at least 1
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 20 * time.Millisecond,
+	MaxBackoff:     time.Second,
+	Multiplier:     2,
+}
+
+// NoRetry fails at the first error
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// backoff returns the wait before attempt (1 is the first retry)
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		d = time.Duration(float64(d) * p.Multiplier)
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return d
+}
+
+type Options struct {
+	// kvrpc addresses used to fetch the topology, one is enough
+	Seeds []string
+	// default consistency of the requests
+	Consistency Consistency
+	// default retry policy, DefaultRetryPolicy if MaxAttempts is 0
+	Retry RetryPolicy
+	// timeout of one attempt, 2s if 0
+	RequestTimeout time.Duration
+	// interval of refreshing the topology, 1min if 0, negative disables the refresh
+	RefreshInterval time.Duration
+}
+
+type callOptions struct {
+	consistency Consistency
+	retry       RetryPolicy
+	allowStale  bool
+}
+
+type Option func(*callOptions)
+
+func WithConsistency(consistency Consistency) Option {
+	return func(o *callOptions) {
+		o.consistency = consistency
+	}
+}
+
+func WithRetry(policy RetryPolicy) Option {
+	return func(o *callOptions) {
+		o.retry = policy
+	}
+}
+
+// WithAllowStale returns a writeless read without ErrStale when some replicas did not answer
+func WithAllowStale() Option {
+	return func(o *callOptions) {
+		o.allowStale = true
+	}
+}
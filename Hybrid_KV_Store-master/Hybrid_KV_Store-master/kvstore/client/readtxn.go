@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+)
+
+// ReadTxn is a read-only transaction, all its reads see one causally consistent snapshot
+type ReadTxn struct {
+	c  *Client
+	mu sync.Mutex
+	// pinned by the first Get, nil before
+	snapshot map[string]int32
+}
+
+// BeginReadTxn starts a read-only transaction, the snapshot is pinned by the first Get
+// and covers the causal context of the client at that time
+func (c *Client) BeginReadTxn() *ReadTxn {
+	return &ReadTxn{c: c}
+}
+
+// Get returns the values of keys at the snapshot of the transaction, "" for a missing key.
+// ErrSnapshotTooOld means the nodes have discarded the versions of the snapshot, begin a new transaction
+func (t *ReadTxn) Get(ctx context.Context, keys ...string) ([]string, error) {
+	// the first Get pins the snapshot, concurrent Gets wait for it
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	snapshot := t.snapshot
+	o := t.c.callOptions(nil)
+	res, err := t.c.do(ctx, o, func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		r, err := kv.ReadTxnInCausal(ctx, &kvrpc.ReadTxnInCausalRequest{Keys: keys, Snapshot: snapshot, Vectorclock: vc, Session: session})
+		if err != nil {
+			return nil, err
+		}
+		if r.SnapshotExpired {
+			return nil, ErrSnapshotTooOld
+		}
+		return &reply{values: pairValues(r.Pairs), success: r.Success, vectorclock: r.Vectorclock, session: r.Session, snapshot: r.Snapshot}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if t.snapshot == nil {
+		t.snapshot = res.snapshot
+	}
+	return res.values, nil
+}
+
+// Snapshot returns the vectorclock of the snapshot, nil before the first Get
+func (t *ReadTxn) Snapshot() map[string]int32 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.snapshot
+}
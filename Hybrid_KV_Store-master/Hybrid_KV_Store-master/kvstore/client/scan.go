@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+)
+
+// Entry is a key of a scan with its value and the vectorclock and HLC timestamp of its last write
+type Entry struct {
+	Key     string
+	Value   string
+	Version map[string]int32
+	HLC     int64
+}
+
+// Scan returns up to limit keys in [start, end) in order (end "" means no upper bound, limit 0 means 100).
+// pageToken is "" for the first page, then the returned next; next is "" after the last page
+func (c *Client) Scan(ctx context.Context, start string, end string, limit int, pageToken string) (entries []Entry, next string, err error) {
+	return c.scan(ctx, start, end, "", limit, pageToken)
+}
+
+// ScanPrefix is Scan of the keys with prefix
+func (c *Client) ScanPrefix(ctx context.Context, prefix string, limit int, pageToken string) (entries []Entry, next string, err error) {
+	return c.scan(ctx, "", "", prefix, limit, pageToken)
+}
+
+func (c *Client) scan(ctx context.Context, start string, end string, prefix string, limit int, pageToken string) ([]Entry, string, error) {
+	var r *kvrpc.ScanInCausalResponse
+	_, err := c.do(ctx, c.callOptions(nil), func(ctx context.Context, kv kvrpc.KVClient, vc map[string]int32, session string) (*reply, error) {
+		res, err := kv.ScanInCausal(ctx, &kvrpc.ScanInCausalRequest{Start: start, End: end, Prefix: prefix, Limit: int32(limit), PageToken: pageToken, Vectorclock: vc, Timestamp: time.Now().UnixMilli(), Session: session})
+		if err != nil {
+			return nil, err
+		}
+		r = res
+		return &reply{success: res.Success, vectorclock: res.Vectorclock, session: res.Session}, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	entries := make([]Entry, len(r.Entries))
+	for i, e := range r.Entries {
+		entries[i] = Entry{Key: e.Key, Value: e.Value, Version: e.Version, HLC: e.Hlc}
+	}
+	return entries, r.NextPageToken, nil
+}
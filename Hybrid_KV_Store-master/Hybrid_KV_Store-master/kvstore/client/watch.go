@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchEvent is a put or a delete of a key, VectorClock is the one of the write
+type WatchEvent struct {
+	Deleted     bool
+	Key         string
+	Value       string
+	VectorClock map[string]int32
+}
+
+// Watch calls fn for every change of the keys with prefix until ctx is done or fn returns an error.
+// fromClock resumes after the events covered by it, nil starts with new events. A broken stream is resumed
+// on another node from the clock of the received events and the position in the last write, whose events share
+// one clock; ErrWatchGap means events have been lost
+func (c *Client) Watch(ctx context.Context, prefix string, fromClock map[string]int32, fn func(WatchEvent) error) error {
+	seen := make(map[string]int32, len(fromClock))
+	for id, counter := range fromClock {
+		seen[id] = counter
+	}
+	// the last received event, the stream may break in the middle of its write
+	var lastClock map[string]int32
+	var lastIndex int32
+	failures := 0
+	for {
+		if failures > 0 {
+			if failures >= c.opts.Retry.MaxAttempts {
+				return fmt.Errorf("%w: watch failed %d times", ErrUnavailable, failures)
+			}
+			timer := time.NewTimer(c.opts.Retry.backoff(failures))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return contextError(ctx.Err())
+			case <-timer.C:
+			}
+		}
+		address, err := c.pick()
+		if err != nil {
+			return err
+		}
+		conn, err := c.conn(address)
+		if err != nil {
+			c.skip(address)
+			failures++
+			continue
+		}
+		stream, err := kvrpc.NewKVClient(conn).Watch(ctx, &kvrpc.WatchRequest{
+			Prefix:         prefix,
+			FromClock:      seen,
+			FromWriteClock: lastClock,
+			FromWriteIndex: lastIndex,
+		})
+		for err == nil {
+			var event *kvrpc.WatchEvent
+			event, err = stream.Recv()
+			if err != nil {
+				break
+			}
+			failures = 0
+			lastClock, lastIndex = event.Vectorclock, event.Index
+			for id, counter := range event.Vectorclock {
+				if counter > seen[id] {
+					seen[id] = counter
+				}
+			}
+			if err := fn(WatchEvent{
+				Deleted:     event.Type == kvrpc.WatchEvent_DELETE,
+				Key:         event.Key,
+				Value:       event.Value,
+				VectorClock: event.Vectorclock,
+			}); err != nil {
+				return err
+			}
+		}
+		if ctx.Err() != nil {
+			return contextError(ctx.Err())
+		}
+		if status.Code(err) == codes.OutOfRange {
+			return fmt.Errorf("%w: %v", ErrWatchGap, err)
+		}
+		c.skip(address)
+		failures++
+	}
+}
@@ -2,6 +2,7 @@ package kvclient
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"math/rand"
@@ -27,6 +28,37 @@ type KVClient struct {
 	ConsistencyLevel int32
 	KvsId            int // target node
 	PutSpentTimeArr  []int
+	// session token issued by the servers, replaces Vectorclock in the requests once set
+	Session string
+	// topology from GetClusterInfo, refreshed every RefreshInterval (0 disables the refresh)
+	Seeds           []string
+	Nodes           []*kvrpc.NodeInfo
+	RefreshInterval time.Duration
+	lastRefresh     time.Time
+}
+
+// status of a node in GetClusterInfo
+const nodeStatusUp = "up"
+
+// NewKVClient bootstraps Kvservers and Vectorclock from the first seed that answers GetClusterInfo
+func NewKVClient(seeds []string, refreshInterval time.Duration) (*KVClient, error) {
+	kvc := &KVClient{
+		Vectorclock:     make(map[string]int32),
+		Seeds:           seeds,
+		RefreshInterval: refreshInterval,
+	}
+	if err := kvc.RefreshClusterInfo(); err != nil {
+		return nil, err
+	}
+	return kvc, nil
+}
+
+// requestClock returns the vectorclock sent with a request, nil if the client has a session token
+func (kvc *KVClient) requestClock() map[string]int32 {
+	if kvc.Session != "" {
+		return nil
+	}
+	return kvc.Vectorclock
 }
 
 // func MakeKVClient(kvservers []string) *KVClient {
@@ -41,6 +73,94 @@ const (
 	BoundedStaleness
 )
 
+/*
+	Cluster Topology
+*/
+// Method of Send RPC of GetClusterInfo
+func (kvc *KVClient) SendGetClusterInfo(address string) (*kvrpc.GetClusterInfoResponse, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		util.EPrintf("err in SendGetClusterInfo: %v", err)
+		return nil, err
+	}
+	defer conn.Close()
+	client := kvrpc.NewKVClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	reply, err := client.GetClusterInfo(ctx, &kvrpc.GetClusterInfoRequest{})
+	if err != nil {
+		util.EPrintf("err in SendGetClusterInfo: %v", err)
+		return nil, err
+	}
+	return reply, nil
+}
+
+// RefreshClusterInfo asks the known nodes first, then the seeds
+func (kvc *KVClient) RefreshClusterInfo() error {
+	kvc.lastRefresh = time.Now()
+	candidates := append(append([]string{}, kvc.Kvservers...), kvc.Seeds...)
+	err := errors.New("kvclient: no seed address")
+	for _, address := range candidates {
+		var reply *kvrpc.GetClusterInfoResponse
+		reply, err = kvc.SendGetClusterInfo(address)
+		if err == nil {
+			kvc.applyClusterInfo(reply.Nodes)
+			return nil
+		}
+	}
+	return err
+}
+
+// applyClusterInfo keeps the current target node if it is still up
+func (kvc *KVClient) applyClusterInfo(nodes []*kvrpc.NodeInfo) {
+	if kvc.Vectorclock == nil {
+		kvc.Vectorclock = make(map[string]int32)
+	}
+	current := ""
+	if kvc.KvsId < len(kvc.Kvservers) {
+		current = kvc.Kvservers[kvc.KvsId]
+	}
+	servers := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if _, ok := kvc.Vectorclock[node.VectorClockId]; !ok {
+			kvc.Vectorclock[node.VectorClockId] = 0
+		}
+		if node.Status == nodeStatusUp && node.Address != "" {
+			servers = append(servers, node.Address)
+		}
+	}
+	kvc.Nodes = nodes
+	if len(servers) == 0 {
+		return
+	}
+	kvc.Kvservers = servers
+	kvc.KvsId = 0
+	for i, server := range servers {
+		if server == current {
+			kvc.KvsId = i
+		}
+	}
+}
+
+// maybeRefresh is called before every request
+func (kvc *KVClient) maybeRefresh() {
+	if kvc.RefreshInterval <= 0 || time.Since(kvc.lastRefresh) < kvc.RefreshInterval {
+		return
+	}
+	if err := kvc.RefreshClusterInfo(); err != nil {
+		util.EPrintf("err in RefreshClusterInfo: %v", err)
+	}
+}
+
+// zeroClock returns a vectorclock of the known nodes, all 0
+func (kvc *KVClient) zeroClock() map[string]int32 {
+	res := make(map[string]int32, len(kvc.Vectorclock))
+	for id := range kvc.Vectorclock {
+		res[id] = 0
+	}
+	return res
+}
+
 /*
 	CAUSAL
 */
@@ -127,9 +247,11 @@ func (kvc *KVClient) SendGetInWritelessCausal(address string, request *kvrpc.Get
 	Writeless-CAUSAL
 */
 func (kvc *KVClient) GetInWritelessCausal(key string) (string, bool) {
+	kvc.maybeRefresh()
 	request := &kvrpc.GetInWritelessCausalRequest{
 		Key:         key,
-		Vectorclock: kvc.Vectorclock,
+		Vectorclock: kvc.requestClock(),
+		Session:     kvc.Session,
 	}
 	for {
 		request.Timestamp = time.Now().UnixMilli()
@@ -140,6 +262,7 @@ func (kvc *KVClient) GetInWritelessCausal(key string) (string, bool) {
 		}
 		if reply.Vectorclock != nil && reply.Success {
 			kvc.Vectorclock = reply.Vectorclock
+			kvc.Session = reply.Session
 			return reply.Value, reply.Success
 		}
 		// refresh the target node
@@ -150,10 +273,12 @@ func (kvc *KVClient) GetInWritelessCausal(key string) (string, bool) {
 	}
 }
 func (kvc *KVClient) PutInWritelessCausal(key string, value string) bool {
+	kvc.maybeRefresh()
 	request := &kvrpc.PutInWritelessCausalRequest{
 		Key:         key,
 		Value:       value,
-		Vectorclock: kvc.Vectorclock,
+		Vectorclock: kvc.requestClock(),
+		Session:     kvc.Session,
 		Timestamp:   time.Now().UnixMilli(),
 	}
 	// keep sending PutInCausal until success
@@ -165,6 +290,7 @@ func (kvc *KVClient) PutInWritelessCausal(key string, value string) bool {
 		}
 		if reply.Vectorclock != nil && reply.Success {
 			kvc.Vectorclock = reply.Vectorclock
+			kvc.Session = reply.Session
 			return reply.Success
 		}
 		// PutInCausal Failed
@@ -180,9 +306,11 @@ func (kvc *KVClient) PutInWritelessCausal(key string, value string) bool {
 */
 // Client Get Value, Read One Replica
 func (kvc *KVClient) GetInCausal(key string) (string, bool) {
+	kvc.maybeRefresh()
 	request := &kvrpc.GetInCausalRequest{
 		Key:         key,
-		Vectorclock: kvc.Vectorclock,
+		Vectorclock: kvc.requestClock(),
+		Session:     kvc.Session,
 	}
 	for {
 		request.Timestamp = time.Now().UnixMilli()
@@ -193,6 +321,7 @@ func (kvc *KVClient) GetInCausal(key string) (string, bool) {
 		}
 		if reply.Vectorclock != nil && reply.Success {
 			kvc.Vectorclock = reply.Vectorclock
+			kvc.Session = reply.Session
 			return reply.Value, reply.Success
 		}
 		// refresh the target node
@@ -205,14 +334,16 @@ func (kvc *KVClient) GetInCausal(key string) (string, bool) {
 
 // Client Get Value, Read Quorum Replica
 func (kvc *KVClient) GetInCausalWithQuorum(key string) (string, bool) {
+	kvc.maybeRefresh()
 	request := &kvrpc.GetInCausalRequest{
 		Key:         key,
-		Vectorclock: kvc.Vectorclock,
+		Vectorclock: kvc.requestClock(),
+		Session:     kvc.Session,
 	}
 	for {
 		request.Timestamp = time.Now().UnixMilli()
 		LatestReply := &kvrpc.GetInCausalResponse{
-			Vectorclock: util.MakeMap(kvc.Kvservers),
+			Vectorclock: kvc.zeroClock(),
 			Value:       "",
 			Success:     false,
 		}
@@ -230,6 +361,7 @@ func (kvc *KVClient) GetInCausalWithQuorum(key string) (string, bool) {
 		}
 		if LatestReply.Vectorclock != nil && LatestReply.Success {
 			kvc.Vectorclock = LatestReply.Vectorclock
+			kvc.Session = LatestReply.Session
 			return LatestReply.Value, LatestReply.Success
 		}
 		// refresh the target node
@@ -240,10 +372,12 @@ func (kvc *KVClient) GetInCausalWithQuorum(key string) (string, bool) {
 
 // Client Put Value
 func (kvc *KVClient) PutInCausal(key string, value string) bool {
+	kvc.maybeRefresh()
 	request := &kvrpc.PutInCausalRequest{
 		Key:         key,
 		Value:       value,
-		Vectorclock: kvc.Vectorclock,
+		Vectorclock: kvc.requestClock(),
+		Session:     kvc.Session,
 		Timestamp:   time.Now().UnixMilli(),
 	}
 	// keep sending PutInCausal until success
@@ -255,6 +389,7 @@ func (kvc *KVClient) PutInCausal(key string, value string) bool {
 		}
 		if reply.Vectorclock != nil && reply.Success {
 			kvc.Vectorclock = reply.Vectorclock
+			kvc.Session = reply.Session
 			return reply.Success
 		}
 		// PutInCausal Failed
@@ -265,6 +400,88 @@ func (kvc *KVClient) PutInCausal(key string, value string) bool {
 	}
 }
 
+/*
+	Compare-And-Set
+*/
+// Method of Send RPC of CompareAndSetInCausal
+func (kvc *KVClient) SendCompareAndSetInCausal(address string, request *kvrpc.CompareAndSetInCausalRequest) (*kvrpc.CompareAndSetInCausalResponse, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		util.EPrintf("err in SendCompareAndSetInCausal: %v", err)
+		return nil, err
+	}
+	defer conn.Close()
+	client := kvrpc.NewKVClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	reply, err := client.CompareAndSetInCausal(ctx, request)
+	if err != nil {
+		util.EPrintf("err in SendCompareAndSetInCausal: %v", err)
+		return nil, err
+	}
+	return reply, nil
+}
+
+// Method of Send RPC of CompareAndSetInStrong
+func (kvc *KVClient) SendCompareAndSetInStrong(address string, request *kvrpc.CompareAndSetInStrongRequest) (*kvrpc.CompareAndSetInStrongResponse, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		util.EPrintf("err in SendCompareAndSetInStrong: %v", err)
+		return nil, err
+	}
+	defer conn.Close()
+	client := kvrpc.NewKVClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	reply, err := client.CompareAndSetInStrong(ctx, request)
+	if err != nil {
+		util.EPrintf("err in SendCompareAndSetInStrong: %v", err)
+		return nil, err
+	}
+	return reply, nil
+}
+
+// CompareAndSetInCausal puts request.Value if the condition holds on the node,
+// the reply carries Swapped and the current value and version of the key.
+// The causal context of the client is filled in, a node which has not caught up is skipped
+func (kvc *KVClient) CompareAndSetInCausal(request *kvrpc.CompareAndSetInCausalRequest) (*kvrpc.CompareAndSetInCausalResponse, bool) {
+	kvc.maybeRefresh()
+	request.Vectorclock = kvc.requestClock()
+	request.Session = kvc.Session
+	request.Timestamp = time.Now().UnixMilli()
+	for i := 0; i < len(kvc.Kvservers); i++ {
+		reply, err := kvc.SendCompareAndSetInCausal(kvc.Kvservers[kvc.KvsId], request)
+		if err != nil {
+			util.EPrintf("err in CompareAndSetInCausal: %v", err)
+			return nil, false
+		}
+		if reply.Success {
+			kvc.Vectorclock = reply.Vectorclock
+			kvc.Session = reply.Session
+			return reply, true
+		}
+		util.DPrintf("CompareAndSetInCausal Failed, refresh the target node: %v", kvc.Kvservers[kvc.KvsId])
+		kvc.KvsId = (kvc.KvsId + 1) % len(kvc.Kvservers)
+	}
+	return nil, false
+}
+
+// CompareAndSetInStrong is linearizable, any node forwards it to the primary
+func (kvc *KVClient) CompareAndSetInStrong(request *kvrpc.CompareAndSetInStrongRequest) (*kvrpc.CompareAndSetInStrongResponse, bool) {
+	kvc.maybeRefresh()
+	request.Vectorclock = kvc.requestClock()
+	request.Session = kvc.Session
+	request.Timestamp = time.Now().UnixMilli()
+	reply, err := kvc.SendCompareAndSetInStrong(kvc.Kvservers[kvc.KvsId], request)
+	if err != nil {
+		util.EPrintf("err in CompareAndSetInStrong: %v", err)
+		return nil, false
+	}
+	kvc.Vectorclock = reply.Vectorclock
+	kvc.Session = reply.Session
+	return reply, true
+}
+
 var count int32 = 0
 var putCount int32 = 0
 var getCount int32 = 0
@@ -273,15 +490,12 @@ var falseTime int32 = 0
 // Test the consistency performance at different read/write ratios
 func RequestRatio(cnum int, num int, servers []string, getRatio int, consistencyLevel int, quorum int) {
 	fmt.Printf("servers: %v\n", servers)
-	kvc := KVClient{
-		Kvservers:   make([]string, len(servers)),
-		Vectorclock: make(map[string]int32),
+	kvc, err := NewKVClient(servers, time.Minute)
+	if err != nil {
+		fmt.Printf("bootstrap from %v failed: %v\n", servers, err)
+		return
 	}
 	kvc.ConsistencyLevel = CAUSAL
-	for _, server := range servers {
-		kvc.Vectorclock[server+"1"] = 0
-	}
-	copy(kvc.Kvservers, servers)
 	start_time := time.Now()
 	for i := 0; i < num; i++ {
 		rand.Seed(time.Now().UnixNano())
@@ -331,14 +545,11 @@ func RequestRatio(cnum int, num int, servers []string, getRatio int, consistency
 */
 func benchmarkFromCSV(filepath string, servers []string, clientNumber int) {
 
-	kvc := KVClient{
-		Kvservers:   make([]string, len(servers)),
-		Vectorclock: make(map[string]int32),
-	}
-	for _, server := range servers {
-		kvc.Vectorclock[server+"1"] = 0
+	kvc, err := NewKVClient(servers, time.Minute)
+	if err != nil {
+		fmt.Printf("bootstrap from %v failed: %v\n", servers, err)
+		return
 	}
-	copy(kvc.Kvservers, servers)
 	writeCounts := util.ReadCsv(filepath)
 	for i := 0; i < len(writeCounts); i++ {
 		for j := 0; j < int(writeCounts[i]); j++ {
@@ -0,0 +1,381 @@
+package main
+
+/*
+	批量读写
+	MultiPut的所有写只占用一个vectorclock增量，作为一个batch lattice同步给其它节点，接收端整体应用
+	writeless模式下batch中被预测延迟的写不在该lattice中，之后由syncHistoryPuts刷新时另占一个vectorclock增量
+	MultiGet在applyMu的读锁下读取所有key，返回的值和vectorclock属于同一个因果快照
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/lattices"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/eventualrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/tcprpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tickClock merges the vectorclock of the client and increments the entry of this node once
+func (kvs *KVServer) tickClock(vcFromClientArg map[string]int32) {
+	vcFromClient := util.BecomeSyncMap(vcFromClientArg)
+	if !util.IsUpper(kvs.vectorclock, vcFromClient) {
+		// vcFromClient is bigger than kvs.vectorclock
+		kvs.MergeVC(vcFromClient)
+	}
+	val, _ := kvs.vectorclock.Load(kvs.internalAddress)
+	kvs.vectorclock.Store(kvs.internalAddress, val.(int32)+1)
+}
+
+// batchLattice encodes the writes as one lattice at the current vectorclock and ts
+func (kvs *KVServer) batchLattice(logs []config.Log, ts int64) []byte {
+	ml := lattices.HybridLattice{
+		Vl: lattices.ValueLattice{
+			VectorClock: util.BecomeMap(kvs.vectorclock),
+		},
+		Batch:  logs,
+		HLC:    ts,
+		Origin: kvs.internalAddress,
+	}
+	data, _ := json.Marshal(ml)
+	return data
+}
+
+func (kvs *KVServer) startBatchInCausal(logs []config.Log, vcFromClient map[string]int32, timestampFromClient int64) bool {
+	util.DPrintf("Batch in Start(): %v ", logs)
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	kvs.tickClock(vcFromClient)
+	now := kvs.clock.Now()
+	args := &causalrpc.AppendEntriesInCausalRequest{
+		MapLattice: kvs.batchLattice(logs, now),
+		Version:    1,
+	}
+	for i := 0; i < len(kvs.peers); i++ {
+		if kvs.peers[i] != kvs.internalAddress {
+			go kvs.sendAppendEntriesInCausal(kvs.peers[i], args)
+		}
+	}
+	kvs.applyLogsLocked(logs, util.BecomeMap(kvs.vectorclock), now, kvs.internalAddress)
+	return true
+}
+
+// the puts deferred by prediction stay out of the batch lattice, the others are synced together
+func (kvs *KVServer) startBatchInWritelessCausal(logs []config.Log, vcFromClient map[string]int32, timestampFromClient int64) bool {
+	util.DPrintf("Batch in Start(): %v ", logs)
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	kvs.tickClock(vcFromClient)
+	now := kvs.clock.Now()
+	synced := make([]config.Log, 0, len(logs))
+	for _, log := range logs {
+		kvs.recorder.RecordPut(log.Key)
+		putCounts_int := util.LoadInt(kvs.putCountsInProxy, log.Key)
+		predictCounts_int := kvs.stats.Predict(log.Key)
+		if log.Option != "Put" || putCounts_int >= predictCounts_int {
+			synced = append(synced, log)
+			kvs.putCountsInProxy.Delete(log.Key)
+			kvs.clearDeferred(log.Key)
+		} else {
+			kvs.deferPut(log.Key, log.Value)
+		}
+	}
+	if len(synced) > 0 {
+		util.DPrintf("Sync Batch Puts by Prediction: %v of %v", len(synced), len(logs))
+		args := &causalrpc.AppendEntriesInCausalRequest{
+			MapLattice: kvs.batchLattice(synced, now),
+			Version:    1,
+		}
+		for i := 0; i < len(kvs.peers); i++ {
+			if kvs.peers[i] != kvs.internalAddress {
+				go kvs.sendAppendEntriesInCausal(kvs.peers[i], args)
+			}
+		}
+	}
+	kvs.applyLogsLocked(logs, util.BecomeMap(kvs.vectorclock), now, kvs.internalAddress)
+	return true
+}
+
+func (kvs *KVServer) startBatchInEventual(logs []config.Log, vcFromClient map[string]int32, timestampFromClient int64) bool {
+	util.DPrintf("Batch in Start(): %v ", logs)
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	kvs.tickClock(vcFromClient)
+	now := kvs.clock.Now()
+	args := &eventualrpc.AppendEntriesInEventualRequest{
+		MapLattice: kvs.batchLattice(logs, now),
+		Version:    1,
+	}
+	for i := 0; i < len(kvs.peers); i++ {
+		if kvs.peers[i] != kvs.internalAddress {
+			go kvs.sendAppendEntriesInEventual(kvs.peers[i], args)
+		}
+	}
+	kvs.applyLogsLocked(logs, util.BecomeMap(kvs.vectorclock), now, kvs.internalAddress)
+	return true
+}
+
+// readKeys executes a MultiGet for a client at vc, values[i] is nil if keys[i] does not exist
+func (kvs *KVServer) readKeys(consistency string, vc map[string]int32, keys []string) (values [][]byte, laggingNodes []string, newVC map[string]int32, ok bool) {
+	ts := time.Now().UnixMicro()
+	vc = kvs.completeClock(vc)
+	ok = true
+	switch consistency {
+	case ConsistencyWritelessCausal:
+		for _, key := range keys {
+			op := config.Log{
+				Option: "Get",
+				Key:    key,
+			}
+			if !kvs.startInWritelessCausal(op, vc, ts) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			laggingNodes = kvs.pullDeferredKeys(keys)
+		}
+	case ConsistencyEventual:
+	default:
+		op := config.Log{
+			Option: "Get",
+		}
+		ok = kvs.startInCausal(op, vc, ts)
+	}
+	if !ok {
+		return nil, nil, vc, false
+	}
+	kvs.applyMu.RLock()
+	defer kvs.applyMu.RUnlock()
+	values = make([][]byte, len(keys))
+	for i, key := range keys {
+		if kvs.store.Has(key) {
+			values[i] = append([]byte{}, kvs.store.Get(key)...)
+		}
+	}
+	return values, laggingNodes, util.BecomeMap(kvs.vectorclock), true
+}
+
+// pullDeferredKeys pulls the deferred puts of all keys in parallel, returns every lagging peer once
+func (kvs *KVServer) pullDeferredKeys(keys []string) []string {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	lagging := make(map[string]bool)
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			peers := kvs.pullDeferred(key)
+			mu.Lock()
+			for _, peer := range peers {
+				lagging[peer] = true
+			}
+			mu.Unlock()
+		}(key)
+	}
+	wg.Wait()
+	laggingNodes := make([]string, 0, len(lagging))
+	for _, peer := range kvs.peers {
+		if lagging[peer] {
+			laggingNodes = append(laggingNodes, peer)
+		}
+	}
+	return laggingNodes
+}
+
+// writeLogs executes the writes of a MultiPut for a client at vc as one batch
+func (kvs *KVServer) writeLogs(consistency string, vc map[string]int32, logs []config.Log) (newVC map[string]int32, ok bool) {
+	ts := time.Now().UnixMicro()
+	vc = kvs.completeClock(vc)
+	if len(logs) == 0 {
+		return util.BecomeMap(kvs.vectorclock), true
+	}
+	switch consistency {
+	case ConsistencyWritelessCausal:
+		for _, log := range logs {
+			if log.Option == "Put" {
+				proxyCounts := util.LoadInt(kvs.putCountsInProxy, log.Key)
+				kvs.putCountsInProxy.Store(log.Key, proxyCounts+1)
+				kvs.stats.AddPut(log.Key)
+			}
+		}
+		ok = kvs.startBatchInWritelessCausal(logs, vc, ts)
+	case ConsistencyEventual:
+		ok = kvs.startBatchInEventual(logs, vc, ts)
+	default:
+		ok = kvs.startBatchInCausal(logs, vc, ts)
+	}
+	if !ok {
+		return vc, false
+	}
+	return util.BecomeMap(kvs.vectorclock), true
+}
+
+// keyValues pairs the keys with the values of readKeys
+func keyValues(keys []string, values [][]byte) []*kvrpc.KeyValue {
+	pairs := make([]*kvrpc.KeyValue, len(keys))
+	for i, key := range keys {
+		pairs[i] = &kvrpc.KeyValue{Key: key}
+		if values != nil && values[i] != nil {
+			pairs[i].Value = string(values[i])
+			pairs[i].Found = true
+		}
+	}
+	return pairs
+}
+
+func putLogs(pairs []*kvrpc.KeyValue) []config.Log {
+	logs := make([]config.Log, len(pairs))
+	for i, pair := range pairs {
+		logs[i] = config.Log{
+			Option: "Put",
+			Key:    pair.Key,
+			Value:  pair.Value,
+		}
+	}
+	return logs
+}
+
+func (kvs *KVServer) MultiGetInCausal(ctx context.Context, in *kvrpc.MultiGetInCausalRequest) (*kvrpc.MultiGetInCausalResponse, error) {
+	util.DPrintf("MultiGetInCausal %v", in.Keys)
+	multiGetInCausalResponse := new(kvrpc.MultiGetInCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	values, _, newVC, ok := kvs.readKeys(ConsistencyCausal, vc, in.Keys)
+	multiGetInCausalResponse.Success = ok
+	if ok {
+		multiGetInCausalResponse.Pairs = keyValues(in.Keys, values)
+	}
+	multiGetInCausalResponse.Vectorclock = newVC
+	multiGetInCausalResponse.Session = kvs.sessions.Encode(newVC)
+	return multiGetInCausalResponse, nil
+}
+
+func (kvs *KVServer) MultiPutInCausal(ctx context.Context, in *kvrpc.MultiPutInCausalRequest) (*kvrpc.MultiPutInCausalResponse, error) {
+	util.DPrintf("MultiPutInCausal %v", in.Pairs)
+	multiPutInCausalResponse := new(kvrpc.MultiPutInCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	newVC, ok := kvs.writeLogs(ConsistencyCausal, vc, putLogs(in.Pairs))
+	multiPutInCausalResponse.Success = ok
+	multiPutInCausalResponse.Vectorclock = newVC
+	multiPutInCausalResponse.Session = kvs.sessions.Encode(newVC)
+	return multiPutInCausalResponse, nil
+}
+
+func (kvs *KVServer) MultiGetInWritelessCausal(ctx context.Context, in *kvrpc.MultiGetInWritelessCausalRequest) (*kvrpc.MultiGetInWritelessCausalResponse, error) {
+	util.DPrintf("MultiGetInWritelessCausal %v", in.Keys)
+	multiGetInWritelessCausalResponse := new(kvrpc.MultiGetInWritelessCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	values, laggingNodes, newVC, ok := kvs.readKeys(ConsistencyWritelessCausal, vc, in.Keys)
+	multiGetInWritelessCausalResponse.Success = ok
+	if ok {
+		multiGetInWritelessCausalResponse.Pairs = keyValues(in.Keys, values)
+		multiGetInWritelessCausalResponse.LaggingNodes = laggingNodes
+		multiGetInWritelessCausalResponse.Stale = len(laggingNodes) > 0
+	}
+	multiGetInWritelessCausalResponse.Vectorclock = newVC
+	multiGetInWritelessCausalResponse.Session = kvs.sessions.Encode(newVC)
+	return multiGetInWritelessCausalResponse, nil
+}
+
+func (kvs *KVServer) MultiPutInWritelessCausal(ctx context.Context, in *kvrpc.MultiPutInWritelessCausalRequest) (*kvrpc.MultiPutInWritelessCausalResponse, error) {
+	util.DPrintf("MultiPutInWritelessCausal %v", in.Pairs)
+	multiPutInWritelessCausalResponse := new(kvrpc.MultiPutInWritelessCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	newVC, ok := kvs.writeLogs(ConsistencyWritelessCausal, vc, putLogs(in.Pairs))
+	multiPutInWritelessCausalResponse.Success = ok
+	multiPutInWritelessCausalResponse.Vectorclock = newVC
+	multiPutInWritelessCausalResponse.Session = kvs.sessions.Encode(newVC)
+	return multiPutInWritelessCausalResponse, nil
+}
+
+func (kvs *KVServer) MultiGetInEventual(ctx context.Context, in *kvrpc.MultiGetInEventualRequest) (*kvrpc.MultiGetInEventualResponse, error) {
+	util.DPrintf("MultiGetInEventual %v", in.Keys)
+	multiGetInEventualResponse := new(kvrpc.MultiGetInEventualResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	values, _, newVC, ok := kvs.readKeys(ConsistencyEventual, vc, in.Keys)
+	multiGetInEventualResponse.Success = ok
+	multiGetInEventualResponse.Pairs = keyValues(in.Keys, values)
+	multiGetInEventualResponse.Vectorclock = newVC
+	multiGetInEventualResponse.Session = kvs.sessions.Encode(newVC)
+	return multiGetInEventualResponse, nil
+}
+
+func (kvs *KVServer) MultiPutInEventual(ctx context.Context, in *kvrpc.MultiPutInEventualRequest) (*kvrpc.MultiPutInEventualResponse, error) {
+	util.DPrintf("MultiPutInEventual %v", in.Pairs)
+	multiPutInEventualResponse := new(kvrpc.MultiPutInEventualResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	newVC, ok := kvs.writeLogs(ConsistencyEventual, vc, putLogs(in.Pairs))
+	multiPutInEventualResponse.Success = ok
+	multiPutInEventualResponse.Vectorclock = newVC
+	multiPutInEventualResponse.Session = kvs.sessions.Encode(newVC)
+	return multiPutInEventualResponse, nil
+}
+
+// handleTCPMultiGet serves MultiGetIn* of the TCP protocol
+func (kvs *KVServer) handleTCPMultiGet(consistency string, message *tcprpc.Request, vc map[string]int32, tcpResp *tcprpc.Response) {
+	util.DPrintf("TCP MultiGet in %s: %v", consistency, message.Keys)
+	values, laggingNodes, newVC, ok := kvs.readKeys(consistency, vc, message.Keys)
+	tcpResp.Operation = "MultiGet"
+	tcpResp.Success = ok
+	if ok {
+		tcpResp.Keys = message.Keys
+		tcpResp.Values = make([]string, len(values))
+		tcpResp.Found = make([]bool, len(values))
+		for i, value := range values {
+			tcpResp.Values[i] = string(value)
+			tcpResp.Found[i] = value != nil
+		}
+		tcpResp.LaggingNodes = laggingNodes
+		tcpResp.Stale = len(laggingNodes) > 0
+		tcpResp.VectorClock = newVC
+		tcpResp.Session = kvs.sessions.Encode(newVC)
+	}
+}
+
+// handleTCPMultiPut serves MultiPutIn* of the TCP protocol, Keys and Values are paired by index
+func (kvs *KVServer) handleTCPMultiPut(consistency string, message *tcprpc.Request, vc map[string]int32, tcpResp *tcprpc.Response) {
+	util.DPrintf("TCP MultiPut in %s: %v", consistency, message.Keys)
+	if len(message.Keys) != len(message.Values) {
+		tcpResp.Error = "keys and values differ in length"
+		return
+	}
+	logs := make([]config.Log, len(message.Keys))
+	for i := range message.Keys {
+		logs[i] = config.Log{
+			Option: "Put",
+			Key:    message.Keys[i],
+			Value:  message.Values[i],
+		}
+	}
+	newVC, ok := kvs.writeLogs(consistency, vc, logs)
+	tcpResp.Operation = "MultiPut"
+	tcpResp.Success = ok
+	tcpResp.VectorClock = newVC
+	tcpResp.Session = kvs.sessions.Encode(newVC)
+}
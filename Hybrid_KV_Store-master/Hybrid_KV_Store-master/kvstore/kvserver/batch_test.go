@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/lattices"
+	"github.com/JasonLou99/Hybrid_KV_Store/store"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"github.com/JasonLou99/Hybrid_KV_Store/writeless"
+)
+
+// testServer makes a node of peers which serves nothing, its lattices to the peers are never delivered
+func testServer(t *testing.T, internalAddress string, peers []string) *KVServer {
+	t.Helper()
+	kvs := MakeKVServer("", internalAddress, peers, t.TempDir(), store.Options{Engine: store.EngineMemory})
+	kvs.stats = writeless.NewStats(time.Hour, 1000, time.Hour)
+	kvs.watches = newWatchHub(16)
+	return kvs
+}
+
+// a batch with a synced and a deferred put: the peer gets the synced part at the clock of the batch,
+// the flush of the deferred put later has to be newer
+func TestBatchDeferredFlush(t *testing.T) {
+	peers := []string{"127.0.0.1:1", "127.0.0.1:2"}
+	a := testServer(t, peers[0], peers)
+	b := testServer(t, peers[1], peers)
+	// "deferred" is read once every 10 puts, its puts are deferred. Two gets, the counts decay below 1 at once
+	for i := 0; i < 20; i++ {
+		a.stats.AddPut("deferred")
+	}
+	a.stats.AddGet("deferred")
+	a.stats.AddGet("deferred")
+
+	_, ok := a.writeLogs(ConsistencyWritelessCausal, nil, []config.Log{
+		{Option: "Put", Key: "synced", Value: "s"},
+		{Option: "Put", Key: "deferred", Value: "d"},
+	})
+	if !ok {
+		t.Fatal("the batch failed")
+	}
+	if _, ok := a.deferred["deferred"]; !ok {
+		t.Fatal("the put of deferred was synced")
+	}
+	if _, ok := a.deferred["synced"]; ok {
+		t.Fatal("the put of synced was deferred")
+	}
+
+	// the synced part as the peer receives it
+	a.applyMu.RLock()
+	synced := lattices.HybridLattice{
+		Vl:     lattices.ValueLattice{VectorClock: util.BecomeMap(a.vectorclock)},
+		Batch:  []config.Log{{Option: "Put", Key: "synced", Value: "s"}},
+		HLC:    a.clock.Now(),
+		Origin: a.internalAddress,
+	}
+	a.applyMu.RUnlock()
+	if !b.applyLatticeInCausal(synced) {
+		t.Fatal("the peer rejected the synced part")
+	}
+
+	var flushed lattices.HybridLattice
+	json.Unmarshal(a.syncHistoryPuts("deferred"), &flushed)
+	if !b.applyLatticeInCausal(flushed) {
+		t.Fatalf("the peer at %v rejected the flush at %v", util.BecomeMap(b.vectorclock), flushed.Vl.VectorClock)
+	}
+	if value := b.store.Get("deferred"); string(value) != "d" {
+		t.Fatalf("deferred is %q on the peer, expected d", value)
+	}
+	if _, ok := a.deferred["deferred"]; ok {
+		t.Fatal("the flushed put is still deferred")
+	}
+}
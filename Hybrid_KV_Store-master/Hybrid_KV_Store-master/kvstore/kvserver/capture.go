@@ -0,0 +1,40 @@
+package main
+
+/*
+	CDC: 在applyLogsLocked中按应用的顺序记录每个写，-cdcDir为空时关闭
+*/
+
+import (
+	"github.com/JasonLou99/Hybrid_KV_Store/cdc"
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+// capture requires applyMu
+func (kvs *KVServer) capture(logs []config.Log, vc map[string]int32, ts int64, origin string) {
+	if kvs.changes == nil {
+		return
+	}
+	for _, log := range logs {
+		record := cdc.Record{
+			Key:         log.Key,
+			Origin:      origin,
+			VectorClock: vc,
+			HLC:         ts,
+		}
+		switch log.Option {
+		case "Delete":
+			record.Op = "delete"
+		case "Expire":
+			record.Op = "expire"
+			record.Value = log.Value
+		default:
+			record.Op = "put"
+			record.Value = log.Value
+			record.TTL = log.TTL
+		}
+		if err := kvs.changes.Append(record); err != nil {
+			util.EPrintf("capture %s failed: %v", log.Key, err)
+		}
+	}
+}
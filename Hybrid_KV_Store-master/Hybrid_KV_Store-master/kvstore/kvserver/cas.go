@@ -0,0 +1,386 @@
+package main
+
+/*
+	Compare-and-set
+	条件: key的值等于expected value / key的版本(最后一次写的vectorclock)等于expected version / key不存在
+	causal: 在本节点上比较并写入，比较和写入在applyMu下原子执行，写入照常异步同步给其它节点
+	strong: 转发到主节点，由strongMu串行执行，同步复制到多数节点之后才返回，因此是可线性化的
+	没有复制到多数节点时返回Unavailable，写已经应用在主节点上，结果未知，客户端需要读取之后再决定是否重试
+	主节点固定为地址最小的peer(与-peers的顺序无关，所有节点选出同一个)，不是主节点的节点拒绝转发来的请求
+	没有选举也没有故障转移: 主节点宕机时strong compare-and-set不可用，直到它恢复
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/lattices"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/tcprpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	CompareValue   = "value"
+	CompareVersion = "version"
+	CompareAbsent  = "absent"
+	// timeout of forwarding to the primary and of the synchronous replication to a peer
+	strongTimeout = 2 * time.Second
+)
+
+var (
+	errNotPrimary    = errors.New("the node is not the primary")
+	errNotReplicated = errors.New("not replicated to a majority, the write may or may not take effect")
+)
+
+// casRequest is a compare-and-set, forwarded to the primary as json
+type casRequest struct {
+	Key             string
+	Value           string
+	Condition       string
+	ExpectedValue   string
+	ExpectedVersion map[string]int32
+	// causal context of the client
+	VectorClock map[string]int32
+}
+
+// casResult holds the value and the version of the key after the compare-and-set
+type casResult struct {
+	Swapped     bool
+	Value       string
+	Version     map[string]int32
+	VectorClock map[string]int32
+}
+
+func compareCondition(c kvrpc.CompareCondition) string {
+	switch c {
+	case kvrpc.CompareCondition_COMPARE_VERSION:
+		return CompareVersion
+	case kvrpc.CompareCondition_COMPARE_ABSENT:
+		return CompareAbsent
+	}
+	return CompareValue
+}
+
+// keyVersion requires applyMu, returns nil if the key does not exist.
+// keys written before the restart of this node have the zero vectorclock
+func (kvs *KVServer) keyVersion(key string) map[string]int32 {
+	if !kvs.store.Has(key) {
+		return nil
+	}
+	version, _ := kvs.store.LatestVersion(key)
+	return kvs.completeClock(version.VectorClock)
+}
+
+// sameVersion compares two vectorclocks, missing entries are 0
+func sameVersion(a map[string]int32, b map[string]int32) bool {
+	for id, counter := range a {
+		if b[id] != counter {
+			return false
+		}
+	}
+	for id, counter := range b {
+		if a[id] != counter {
+			return false
+		}
+	}
+	return true
+}
+
+// compareLocked requires applyMu
+func (kvs *KVServer) compareLocked(req casRequest) bool {
+	switch req.Condition {
+	case CompareValue:
+		return kvs.store.Has(req.Key) && string(kvs.store.Get(req.Key)) == req.ExpectedValue
+	case CompareVersion:
+		version := kvs.keyVersion(req.Key)
+		return version != nil && sameVersion(version, req.ExpectedVersion)
+	case CompareAbsent:
+		return !kvs.store.Has(req.Key)
+	}
+	return false
+}
+
+// compareAndSetLocked requires applyMu, args is the lattice of the write to replicate, nil if not swapped
+func (kvs *KVServer) compareAndSetLocked(req casRequest) (res casResult, args *causalrpc.AppendEntriesInCausalRequest) {
+	if kvs.compareLocked(req) {
+		newLog := config.Log{
+			Option: "Put",
+			Key:    req.Key,
+			Value:  req.Value,
+		}
+		kvs.tickClock(req.VectorClock)
+		vc := util.BecomeMap(kvs.vectorclock)
+		now := kvs.clock.Now()
+		ml := lattices.HybridLattice{
+			Key: newLog.Key,
+			Vl: lattices.ValueLattice{
+				Log:         newLog,
+				VectorClock: vc,
+			},
+			HLC:    now,
+			Origin: kvs.internalAddress,
+		}
+		data, _ := json.Marshal(ml)
+		args = &causalrpc.AppendEntriesInCausalRequest{
+			MapLattice: data,
+			Version:    1,
+		}
+		kvs.applyLogsLocked([]config.Log{newLog}, vc, now, kvs.internalAddress)
+		res.Swapped = true
+	}
+	if kvs.store.Has(req.Key) {
+		res.Value = string(kvs.store.Get(req.Key))
+	}
+	res.Version = kvs.keyVersion(req.Key)
+	res.VectorClock = util.BecomeMap(kvs.vectorclock)
+	return res, args
+}
+
+// compareAndSetInCausal returns false if this node has not caught up with the client
+func (kvs *KVServer) compareAndSetInCausal(req casRequest) (casResult, bool) {
+	req.VectorClock = kvs.completeClock(req.VectorClock)
+	if !util.IsUpper(kvs.vectorclock, util.BecomeSyncMap(req.VectorClock)) {
+		return casResult{VectorClock: req.VectorClock}, false
+	}
+	kvs.applyMu.Lock()
+	res, args := kvs.compareAndSetLocked(req)
+	kvs.applyMu.Unlock()
+	if args != nil {
+		for i := 0; i < len(kvs.peers); i++ {
+			if kvs.peers[i] != kvs.internalAddress {
+				go kvs.sendAppendEntriesInCausal(kvs.peers[i], args)
+			}
+		}
+	}
+	return res, true
+}
+
+// primary returns the node which serves strong compare-and-sets, the lowest internal address of the peers.
+// Like the session tokens it does not depend on the order of -peers, every node picks the same one
+func (kvs *KVServer) primary() string {
+	primary := kvs.peers[0]
+	for _, peer := range kvs.peers[1:] {
+		if peer < primary {
+			primary = peer
+		}
+	}
+	return primary
+}
+
+// compareAndSetInStrong runs on the primary, other nodes forward the request
+func (kvs *KVServer) compareAndSetInStrong(req casRequest) (casResult, error) {
+	req.VectorClock = kvs.completeClock(req.VectorClock)
+	if primary := kvs.primary(); primary != kvs.internalAddress {
+		return kvs.sendForwardCompareAndSetInCausal(primary, req)
+	}
+	kvs.strongMu.Lock()
+	defer kvs.strongMu.Unlock()
+	kvs.applyMu.Lock()
+	res, args := kvs.compareAndSetLocked(req)
+	kvs.applyMu.Unlock()
+	if args != nil {
+		if laggingNodes := kvs.replicateSync(args); len(laggingNodes) > 0 {
+			util.EPrintf("compareAndSetInStrong %s: not replicated to %v", req.Key, laggingNodes)
+			// the primary counts as one replica
+			if len(kvs.peers)-len(laggingNodes) <= len(kvs.peers)/2 {
+				return res, errNotReplicated
+			}
+		}
+	}
+	for id, counter := range req.VectorClock {
+		if counter > res.VectorClock[id] {
+			res.VectorClock[id] = counter
+		}
+	}
+	return res, nil
+}
+
+// replicateSync sends the lattice to all peers in parallel and waits, returns the peers which did not answer
+func (kvs *KVServer) replicateSync(args *causalrpc.AppendEntriesInCausalRequest) []string {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	laggingNodes := make([]string, 0)
+	for i := 0; i < len(kvs.peers); i++ {
+		if kvs.peers[i] == kvs.internalAddress {
+			continue
+		}
+		wg.Add(1)
+		go func(peer string) {
+			defer wg.Done()
+			if !kvs.sendAppendEntriesInStrong(peer, args) {
+				mu.Lock()
+				laggingNodes = append(laggingNodes, peer)
+				mu.Unlock()
+			}
+		}(kvs.peers[i])
+	}
+	wg.Wait()
+	return laggingNodes
+}
+
+// sendAppendEntriesInStrong is sendAppendEntriesInCausal with a timeout, a peer which is down does not block the primary
+func (kvs *KVServer) sendAppendEntriesInStrong(address string, args *causalrpc.AppendEntriesInCausalRequest) bool {
+	// 随机等待，模拟延迟
+	time.Sleep(time.Millisecond * time.Duration(kvs.latency+rand.Intn(25)))
+	ctx, cancel := context.WithTimeout(context.Background(), strongTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		util.EPrintf("sendAppendEntriesInStrong did not connect: %v", err)
+		return false
+	}
+	defer conn.Close()
+	client := causalrpc.NewCAUSALClient(conn)
+	// a lattice already covered by the peer is rejected with false, it is replicated anyway
+	_, err = client.AppendEntriesInCausal(ctx, args)
+	if err != nil {
+		util.EPrintf("sendAppendEntriesInStrong could not greet: %v %v", err, address)
+		return false
+	}
+	return true
+}
+
+func (kvs *KVServer) ForwardCompareAndSetInCausal(ctx context.Context, in *causalrpc.ForwardCompareAndSetInCausalRequest) (*causalrpc.ForwardCompareAndSetInCausalResponse, error) {
+	util.DPrintf("ForwardCompareAndSetInCausal %s", in.Cas)
+	forwardCompareAndSetInCausalResponse := &causalrpc.ForwardCompareAndSetInCausalResponse{}
+	var req casRequest
+	// a node which is not the primary never forwards again, the request is rejected
+	if kvs.primary() != kvs.internalAddress || json.Unmarshal(in.Cas, &req) != nil {
+		return forwardCompareAndSetInCausalResponse, nil
+	}
+	res, err := kvs.compareAndSetInStrong(req)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	forwardCompareAndSetInCausalResponse.Result, _ = json.Marshal(res)
+	forwardCompareAndSetInCausalResponse.Success = true
+	return forwardCompareAndSetInCausalResponse, nil
+}
+
+func (kvs *KVServer) sendForwardCompareAndSetInCausal(address string, req casRequest) (casResult, error) {
+	var res casResult
+	// 转发和主节点的同步复制
+	ctx, cancel := context.WithTimeout(context.Background(), 2*strongTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		util.EPrintf("sendForwardCompareAndSetInCausal did not connect: %v", err)
+		return res, err
+	}
+	defer conn.Close()
+	client := causalrpc.NewCAUSALClient(conn)
+	data, _ := json.Marshal(req)
+	reply, err := client.ForwardCompareAndSetInCausal(ctx, &causalrpc.ForwardCompareAndSetInCausalRequest{Cas: data})
+	if err != nil {
+		util.EPrintf("sendForwardCompareAndSetInCausal could not greet: %v %v", err, address)
+		return res, err
+	}
+	if !reply.Success {
+		return res, errNotPrimary
+	}
+	err = json.Unmarshal(reply.Result, &res)
+	return res, err
+}
+
+func (kvs *KVServer) CompareAndSetInCausal(ctx context.Context, in *kvrpc.CompareAndSetInCausalRequest) (*kvrpc.CompareAndSetInCausalResponse, error) {
+	util.DPrintf("CompareAndSetInCausal %s %s", in.Key, in.Value)
+	compareAndSetInCausalResponse := new(kvrpc.CompareAndSetInCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, ok := kvs.compareAndSetInCausal(casRequest{
+		Key:             in.Key,
+		Value:           in.Value,
+		Condition:       compareCondition(in.Condition),
+		ExpectedValue:   in.ExpectedValue,
+		ExpectedVersion: in.ExpectedVersion,
+		VectorClock:     vc,
+	})
+	compareAndSetInCausalResponse.Success = ok
+	compareAndSetInCausalResponse.Swapped = res.Swapped
+	compareAndSetInCausalResponse.Value = res.Value
+	compareAndSetInCausalResponse.Version = res.Version
+	compareAndSetInCausalResponse.Vectorclock = res.VectorClock
+	compareAndSetInCausalResponse.Session = kvs.sessions.Encode(res.VectorClock)
+	return compareAndSetInCausalResponse, nil
+}
+
+func (kvs *KVServer) CompareAndSetInStrong(ctx context.Context, in *kvrpc.CompareAndSetInStrongRequest) (*kvrpc.CompareAndSetInStrongResponse, error) {
+	util.DPrintf("CompareAndSetInStrong %s %s", in.Key, in.Value)
+	compareAndSetInStrongResponse := new(kvrpc.CompareAndSetInStrongResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, err := kvs.compareAndSetInStrong(casRequest{
+		Key:             in.Key,
+		Value:           in.Value,
+		Condition:       compareCondition(in.Condition),
+		ExpectedValue:   in.ExpectedValue,
+		ExpectedVersion: in.ExpectedVersion,
+		VectorClock:     vc,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "primary "+kvs.primary()+": "+err.Error())
+	}
+	compareAndSetInStrongResponse.Success = true
+	compareAndSetInStrongResponse.Swapped = res.Swapped
+	compareAndSetInStrongResponse.Value = res.Value
+	compareAndSetInStrongResponse.Version = res.Version
+	compareAndSetInStrongResponse.Vectorclock = res.VectorClock
+	compareAndSetInStrongResponse.Session = kvs.sessions.Encode(res.VectorClock)
+	return compareAndSetInStrongResponse, nil
+}
+
+// handleTCPCompareAndSet serves CompareAndSetInCausal and CompareAndSetInStrong of the TCP protocol
+func (kvs *KVServer) handleTCPCompareAndSet(consistency string, message *tcprpc.Request, vc map[string]int32, tcpResp *tcprpc.Response) {
+	util.DPrintf("TCP CompareAndSet in %s: %s %s", consistency, message.Key, message.Value)
+	req := casRequest{
+		Key:             message.Key,
+		Value:           message.Value,
+		Condition:       message.Condition,
+		ExpectedValue:   message.ExpectedValue,
+		ExpectedVersion: message.ExpectedVersion,
+		VectorClock:     vc,
+	}
+	switch req.Condition {
+	case "":
+		req.Condition = CompareValue
+	case CompareValue, CompareVersion, CompareAbsent:
+	default:
+		tcpResp.Error = "unknown condition " + req.Condition
+		return
+	}
+	var res casResult
+	ok := true
+	if consistency == ConsistencyStrong {
+		var err error
+		res, err = kvs.compareAndSetInStrong(req)
+		if err != nil {
+			tcpResp.Error = "primary " + kvs.primary() + ": " + err.Error()
+			return
+		}
+	} else {
+		res, ok = kvs.compareAndSetInCausal(req)
+	}
+	tcpResp.Operation = "CompareAndSet"
+	tcpResp.Key = message.Key
+	tcpResp.Success = ok
+	tcpResp.Swapped = res.Swapped
+	tcpResp.Value = res.Value
+	tcpResp.Version = res.Version
+	tcpResp.VectorClock = res.VectorClock
+	tcpResp.Session = kvs.sessions.Encode(res.VectorClock)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+)
+
+func TestPrimary(t *testing.T) {
+	peers := []string{"127.0.0.1:3", "127.0.0.1:1", "127.0.0.1:2"}
+	for _, order := range [][]string{peers, {peers[1], peers[2], peers[0]}, {peers[2], peers[0], peers[1]}} {
+		kvs := testServer(t, order[0], order)
+		if primary := kvs.primary(); primary != "127.0.0.1:1" {
+			t.Fatalf("peers %v: primary %s, expected the lowest address 127.0.0.1:1", order, primary)
+		}
+	}
+}
+
+func TestForwardToNotPrimary(t *testing.T) {
+	peers := []string{"127.0.0.1:2", "127.0.0.1:1"}
+	kvs := testServer(t, peers[0], peers)
+	data, _ := json.Marshal(casRequest{Key: "k", Value: "v", Condition: CompareAbsent})
+	reply, err := kvs.ForwardCompareAndSetInCausal(context.Background(), &causalrpc.ForwardCompareAndSetInCausalRequest{Cas: data})
+	if err != nil || reply.Success {
+		t.Fatalf("the first of -peers, not the primary, accepted a forwarded compare-and-set: %v %v", reply, err)
+	}
+	if kvs.store.Has("k") {
+		t.Fatal("the rejected compare-and-set was applied")
+	}
+}
+
+func TestStrongCompareAndSet(t *testing.T) {
+	kvs := testServer(t, "127.0.0.1:1", []string{"127.0.0.1:1"})
+	res, err := kvs.compareAndSetInStrong(casRequest{Key: "k", Value: "v1", Condition: CompareAbsent})
+	if err != nil || !res.Swapped {
+		t.Fatalf("absent key: swapped %v, %v", res.Swapped, err)
+	}
+	res, err = kvs.compareAndSetInStrong(casRequest{Key: "k", Value: "v2", Condition: CompareAbsent})
+	if err != nil || res.Swapped || res.Value != "v1" {
+		t.Fatalf("existing key: swapped %v, value %q, %v", res.Swapped, res.Value, err)
+	}
+	res, err = kvs.compareAndSetInStrong(casRequest{Key: "k", Value: "v3", Condition: CompareVersion, ExpectedVersion: res.Version})
+	if err != nil || !res.Swapped || res.Value != "v3" {
+		t.Fatalf("expected version: swapped %v, value %q, %v", res.Swapped, res.Value, err)
+	}
+}
+
+// the primary does not reach the majority, the write is applied but not acknowledged
+func TestStrongCompareAndSetNoMajority(t *testing.T) {
+	peers := []string{"127.0.0.1:1", "127.0.0.1:2", "127.0.0.1:3"}
+	kvs := testServer(t, peers[0], peers)
+	res, err := kvs.compareAndSetInStrong(casRequest{Key: "k", Value: "v", Condition: CompareAbsent})
+	if err != errNotReplicated {
+		t.Fatalf("no peer answered: %v, expected errNotReplicated", err)
+	}
+	if !res.Swapped || string(kvs.store.Get("k")) != "v" {
+		t.Fatal("the write is not applied on the primary")
+	}
+}
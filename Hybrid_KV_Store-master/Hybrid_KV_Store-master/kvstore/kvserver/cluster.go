@@ -0,0 +1,92 @@
+package main
+
+/*
+	集群拓扑：客户端只需一个种子地址，通过GetClusterInfo获得所有节点的各类地址和vectorclock中的id
+	每个节点只知道其它节点的internalAddress，其余地址通过GetNodeInfoInCausal向对应节点询问
+*/
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	NodeStatusUp          = "up"
+	NodeStatusUnreachable = "unreachable"
+	// timeout of asking a peer for its addresses
+	nodeInfoTimeout = time.Second
+)
+
+// consistency levels served by every node, strong only by CompareAndSet
+var consistencyLevels = []string{ConsistencyCausal, ConsistencyWritelessCausal, ConsistencyEventual, ConsistencyStrong}
+
+func (kvs *KVServer) GetNodeInfoInCausal(ctx context.Context, in *causalrpc.GetNodeInfoInCausalRequest) (*causalrpc.GetNodeInfoInCausalResponse, error) {
+	return &causalrpc.GetNodeInfoInCausalResponse{
+		Address:           kvs.address,
+		InternalAddress:   kvs.internalAddress,
+		TcpAddress:        kvs.tcpAddress,
+		RespAddress:       kvs.respAddress,
+		HttpAddress:       kvs.httpAddress,
+		ConsistencyLevels: consistencyLevels,
+	}, nil
+}
+
+// GetClusterInfo returns the nodes in the order of peers, a peer which does not answer is unreachable
+func (kvs *KVServer) GetClusterInfo(ctx context.Context, in *kvrpc.GetClusterInfoRequest) (*kvrpc.GetClusterInfoResponse, error) {
+	util.DPrintf("GetClusterInfo")
+	nodes := make([]*kvrpc.NodeInfo, len(kvs.peers))
+	var wg sync.WaitGroup
+	for i := 0; i < len(kvs.peers); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			node := &kvrpc.NodeInfo{
+				InternalAddress: kvs.peers[i],
+				VectorClockId:   kvs.peers[i],
+				Status:          NodeStatusUnreachable,
+			}
+			var reply *causalrpc.GetNodeInfoInCausalResponse
+			if kvs.peers[i] == kvs.internalAddress {
+				reply, _ = kvs.GetNodeInfoInCausal(ctx, &causalrpc.GetNodeInfoInCausalRequest{})
+			} else {
+				reply, _ = kvs.sendGetNodeInfoInCausal(kvs.peers[i])
+			}
+			if reply != nil {
+				node.Address = reply.Address
+				node.TcpAddress = reply.TcpAddress
+				node.RespAddress = reply.RespAddress
+				node.HttpAddress = reply.HttpAddress
+				node.ConsistencyLevels = reply.ConsistencyLevels
+				node.Status = NodeStatusUp
+			}
+			nodes[i] = node
+		}(i)
+	}
+	wg.Wait()
+	return &kvrpc.GetClusterInfoResponse{Nodes: nodes}, nil
+}
+
+func (kvs *KVServer) sendGetNodeInfoInCausal(address string) (*causalrpc.GetNodeInfoInCausalResponse, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), nodeInfoTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		util.EPrintf("sendGetNodeInfoInCausal did not connect: %v", err)
+		return nil, false
+	}
+	defer conn.Close()
+	client := causalrpc.NewCAUSALClient(conn)
+	reply, err := client.GetNodeInfoInCausal(ctx, &causalrpc.GetNodeInfoInCausalRequest{})
+	if err != nil {
+		util.EPrintf("sendGetNodeInfoInCausal could not greet: %v %v", err, address)
+		return nil, false
+	}
+	return reply, true
+}
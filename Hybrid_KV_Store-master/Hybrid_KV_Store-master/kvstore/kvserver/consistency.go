@@ -0,0 +1,114 @@
+package main
+
+/*
+	按一致性级别执行单个读写，供RESP、HTTP等不区分RPC的前端使用
+	客户端的vectorclock作为参数传入，执行成功后返回节点当前的vectorclock
+*/
+
+import (
+	"strings"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+const (
+	ConsistencyCausal          = "causal"
+	ConsistencyWritelessCausal = "writeless-causal"
+	ConsistencyEventual        = "eventual"
+	// only for CompareAndSet, linearizable on the primary, see KVServer.primary
+	ConsistencyStrong = "strong"
+)
+
+// parseConsistency accepts the names of the consistency levels, returns "" if unknown
+func parseConsistency(level string) string {
+	switch strings.ToLower(level) {
+	case "causal":
+		return ConsistencyCausal
+	case "writeless", "writeless-causal", "writelesscausal":
+		return ConsistencyWritelessCausal
+	case "eventual":
+		return ConsistencyEventual
+	}
+	return ""
+}
+
+// completeClock returns vc with every peer, start* requires the entry of this node
+func (kvs *KVServer) completeClock(vc map[string]int32) map[string]int32 {
+	res := make(map[string]int32, len(kvs.peers))
+	for _, peer := range kvs.peers {
+		res[peer] = 0
+	}
+	for k, v := range vc {
+		res[k] = v
+	}
+	return res
+}
+
+// clientClock returns the causal context of a request, the session token wins over the raw vectorclock
+func (kvs *KVServer) clientClock(token string, vc map[string]int32) (map[string]int32, error) {
+	if token == "" {
+		return kvs.completeClock(vc), nil
+	}
+	return kvs.sessions.Decode(token)
+}
+
+// sessionToken returns the token of the current vectorclock of this node
+func (kvs *KVServer) sessionToken() string {
+	return kvs.sessions.Encode(util.BecomeMap(kvs.vectorclock))
+}
+
+// readKey executes a Get for a client at vc, value is nil if the key does not exist.
+// ok is false if this node has not caught up with vc; laggingNodes are the peers which may still hold deferred puts, see pullDeferred
+func (kvs *KVServer) readKey(consistency string, vc map[string]int32, key string) (value []byte, laggingNodes []string, newVC map[string]int32, ok bool) {
+	op := config.Log{
+		Option: "Get",
+		Key:    key,
+		Value:  "",
+	}
+	ts := time.Now().UnixMicro()
+	vc = kvs.completeClock(vc)
+	switch consistency {
+	case ConsistencyWritelessCausal:
+		ok = kvs.startInWritelessCausal(op, vc, ts)
+		if ok {
+			laggingNodes = kvs.pullDeferred(key)
+		}
+	case ConsistencyEventual:
+		ok = kvs.startInEventual(op, vc, ts)
+	default:
+		ok = kvs.startInCausal(op, vc, ts)
+	}
+	if !ok {
+		return nil, nil, vc, false
+	}
+	if kvs.store.Has(key) {
+		// an empty value is not the nil of a missing key
+		value = append([]byte{}, kvs.store.Get(key)...)
+	}
+	return value, laggingNodes, util.BecomeMap(kvs.vectorclock), true
+}
+
+// writeLog executes a Put, Delete or Expire for a client at vc
+func (kvs *KVServer) writeLog(consistency string, vc map[string]int32, op config.Log) (newVC map[string]int32, ok bool) {
+	ts := time.Now().UnixMicro()
+	vc = kvs.completeClock(vc)
+	switch consistency {
+	case ConsistencyWritelessCausal:
+		if op.Option == "Put" {
+			proxyCounts := util.LoadInt(kvs.putCountsInProxy, op.Key)
+			kvs.putCountsInProxy.Store(op.Key, proxyCounts+1)
+			kvs.stats.AddPut(op.Key)
+		}
+		ok = kvs.startInWritelessCausal(op, vc, ts)
+	case ConsistencyEventual:
+		ok = kvs.startInEventual(op, vc, ts)
+	default:
+		ok = kvs.startInCausal(op, vc, ts)
+	}
+	if !ok {
+		return vc, false
+	}
+	return util.BecomeMap(kvs.vectorclock), true
+}
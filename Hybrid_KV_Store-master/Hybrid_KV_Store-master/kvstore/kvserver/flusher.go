@@ -0,0 +1,180 @@
+package main
+
+/*
+	Writeless-Causal 延迟写的强制刷新
+	写次数未达到 predictPutCounts 的key不会同步给其它节点，如果一直没有Get或者节点宕机，其它节点永远看不到这些写
+	后台flusher为延迟写设置上限：单个key的最长延迟时间，以及所有延迟写的总字节数
+*/
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/lattices"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+// puts of one key which are not synced to the peers yet
+type deferredPut struct {
+	// time of the first deferred put
+	since time.Time
+	// size of the latest put, the flush only sends the latest value
+	bytes int
+	// sequence number of the write-ahead log record of the first deferred put
+	walSeq uint64
+}
+
+// deferPut records a put of key that was not synced by prediction, it is called under applyMu before the put is applied
+func (kvs *KVServer) deferPut(key string, value string) {
+	kvs.deferredMu.Lock()
+	dp, ok := kvs.deferred[key]
+	if !ok {
+		dp = &deferredPut{since: time.Now(), walSeq: kvs.nextWALSeq()}
+		kvs.deferred[key] = dp
+	}
+	kvs.deferredBytes += len(key) + len(value) - dp.bytes
+	dp.bytes = len(key) + len(value)
+	overflow := kvs.maxDeferredBytes > 0 && kvs.deferredBytes > kvs.maxDeferredBytes
+	kvs.deferredMu.Unlock()
+	if overflow {
+		// wake up the flusher, never block the put
+		select {
+		case kvs.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// clearDeferred is called once the puts of key have been synced to the peers
+func (kvs *KVServer) clearDeferred(key string) {
+	kvs.deferredMu.Lock()
+	defer kvs.deferredMu.Unlock()
+	if dp, ok := kvs.deferred[key]; ok {
+		kvs.deferredBytes -= dp.bytes
+		delete(kvs.deferred, key)
+	}
+}
+
+// historyLattice wraps the latest values of keys, which cover every deferred put, as one batch lattice.
+// The caller holds applyMu, so the values and the vectorclock belong together
+func (kvs *KVServer) historyLattice(keys []string) []byte {
+	logs := make([]config.Log, 0, len(keys))
+	var ts int64
+	for _, key := range keys {
+		// the key may be deleted or expired since the deferred puts
+		syncLog := config.Log{
+			Option: "Delete",
+			Key:    key,
+		}
+		if ttl, ok := kvs.store.TTL(key); ok {
+			syncLog.Option = "Put"
+			syncLog.Value = string(kvs.store.Get(key))
+			syncLog.TTL = int(ttl)
+		}
+		logs = append(logs, syncLog)
+		// the timestamp of the last deferred put
+		if version, _ := kvs.store.LatestVersion(key); version.Timestamp > ts {
+			ts = version.Timestamp
+		}
+	}
+	ml := lattices.HybridLattice{
+		Vl: lattices.ValueLattice{
+			VectorClock: util.BecomeMap(kvs.vectorclock),
+		},
+		Batch:  logs,
+		HLC:    ts,
+		Origin: kvs.internalAddress,
+	}
+	data, _ := json.Marshal(ml)
+	return data
+}
+
+// syncHistoryPuts sends the latest values of keys to all peers in one lattice, and returns the lattice.
+// A lattice per key would carry the same vectorclock, a peer would apply the first one and reject the others.
+// The flush takes its own vectorclock increment: a synced write after the deferred puts, e.g. the synced part of
+// the same batch, has already reached the peers with the current vectorclock, and they would reject the lattice
+func (kvs *KVServer) syncHistoryPuts(keys ...string) []byte {
+	// a put between reading the values and clearing the deferral would be cleared without being sent
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	kvs.tickClock(nil)
+	// the increment is logged without writes, a restart must not hand it out again
+	kvs.writeAhead(nil, util.BecomeMap(kvs.vectorclock), kvs.clock.Now(), kvs.internalAddress)
+	data := kvs.historyLattice(keys)
+	syncReq := &causalrpc.AppendEntriesInCausalRequest{
+		MapLattice: data,
+		Version:    1,
+	}
+	for i := 0; i < len(kvs.peers); i++ {
+		if kvs.peers[i] != kvs.internalAddress {
+			go kvs.sendAppendEntriesInCausal(kvs.peers[i], syncReq)
+		}
+	}
+	for _, key := range keys {
+		kvs.putCountsInProxy.Delete(key)
+		kvs.clearDeferred(key)
+	}
+	return data
+}
+
+// dueKeys returns the deferred keys which exceed maxDeferral, and the oldest keys until deferredBytes fits in maxDeferredBytes
+func (kvs *KVServer) dueKeys(now time.Time) []string {
+	kvs.deferredMu.Lock()
+	defer kvs.deferredMu.Unlock()
+	due := make(map[string]bool)
+	dueBytes := 0
+	for key, dp := range kvs.deferred {
+		if kvs.maxDeferral > 0 && now.Sub(dp.since) >= kvs.maxDeferral {
+			due[key] = true
+			dueBytes += dp.bytes
+		}
+	}
+	for kvs.maxDeferredBytes > 0 && kvs.deferredBytes-dueBytes > kvs.maxDeferredBytes {
+		oldest := ""
+		var oldestSince time.Time
+		for key, dp := range kvs.deferred {
+			if !due[key] && (oldest == "" || dp.since.Before(oldestSince)) {
+				oldest = key
+				oldestSince = dp.since
+			}
+		}
+		if oldest == "" {
+			break
+		}
+		due[oldest] = true
+		dueBytes += kvs.deferred[oldest].bytes
+	}
+	keys := make([]string, 0, len(due))
+	for key := range due {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// flusher pushes deferred puts to the peers when either limit is crossed
+func (kvs *KVServer) flusher() {
+	if kvs.maxDeferral <= 0 && kvs.maxDeferredBytes <= 0 {
+		return
+	}
+	interval := time.Second
+	if kvs.maxDeferral > 0 {
+		interval = kvs.maxDeferral / 4
+		if interval < 10*time.Millisecond {
+			interval = 10 * time.Millisecond
+		}
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-kvs.flushCh:
+		}
+		if keys := kvs.dueKeys(time.Now()); len(keys) > 0 {
+			util.DPrintf("Sync History Puts by Flusher, keys: %v", keys)
+			kvs.syncHistoryPuts(keys...)
+		}
+	}
+}
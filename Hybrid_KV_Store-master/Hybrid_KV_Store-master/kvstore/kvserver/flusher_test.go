@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+// the deferred bytes follow the latest value of every deferred key and drop to 0 once the keys are synced
+func TestDeferredBytes(t *testing.T) {
+	kvs := testServer(t, "127.0.0.1:1", []string{"127.0.0.1:1", "127.0.0.1:2"})
+	for i := 0; i < 3; i++ {
+		kvs.deferPut("k", "value")
+	}
+	kvs.deferPut("j", "v")
+	if kvs.deferredBytes != 8 {
+		t.Fatalf("deferredBytes %d after overwriting k, expected 8", kvs.deferredBytes)
+	}
+	kvs.deferPut("k", "v")
+	if kvs.deferredBytes != 4 {
+		t.Fatalf("deferredBytes %d after shrinking k, expected 4", kvs.deferredBytes)
+	}
+	kvs.clearDeferred("k")
+	kvs.clearDeferred("j")
+	if kvs.deferredBytes != 0 || len(kvs.deferred) != 0 {
+		t.Fatalf("deferredBytes %d, %d keys after clearing, expected none", kvs.deferredBytes, len(kvs.deferred))
+	}
+}
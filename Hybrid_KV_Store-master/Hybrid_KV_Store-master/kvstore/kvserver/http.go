@@ -0,0 +1,296 @@
+package main
+
+/*
+	HTTP/JSON网关，面向只支持HTTP的wasm runtime和脚本
+	GET/PUT/DELETE /v1/kv/{key}?consistency=causal
+	POST /v1/batch  按顺序执行一组get/put/delete
+	vectorclock通过请求头 X-Hydis-Vector-Clock (JSON) 或请求体的 vector_clock 字段传入，两者都有时以请求体为准
+	响应体和响应头都带有执行后的vectorclock，错误统一为 {"error": {"code": ..., "message": ...}}
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+const (
+	httpVectorClockHeader = "X-Hydis-Vector-Clock"
+	httpKVPrefix          = "/v1/kv/"
+	// max size of a request body
+	httpMaxBody = 64 * 1024 * 1024
+)
+
+type httpError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type httpErrorBody struct {
+	Error httpError `json:"error"`
+}
+
+// body of PUT and DELETE /v1/kv/{key}, every field is optional
+type httpKVRequest struct {
+	Value       string           `json:"value"`
+	TTL         int              `json:"ttl,omitempty"`
+	VectorClock map[string]int32 `json:"vector_clock,omitempty"`
+}
+
+type httpKVResponse struct {
+	Key          string           `json:"key"`
+	Value        *string          `json:"value,omitempty"`
+	Deleted      *bool            `json:"deleted,omitempty"`
+	VectorClock  map[string]int32 `json:"vector_clock"`
+	Stale        bool             `json:"stale,omitempty"`
+	LaggingNodes []string         `json:"lagging_nodes,omitempty"`
+}
+
+type httpBatchOp struct {
+	// get, put or delete
+	Op    string `json:"op"`
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+	TTL   int    `json:"ttl,omitempty"`
+}
+
+type httpBatchRequest struct {
+	Consistency string           `json:"consistency,omitempty"`
+	VectorClock map[string]int32 `json:"vector_clock,omitempty"`
+	Ops         []httpBatchOp    `json:"ops"`
+}
+
+type httpBatchResult struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value,omitempty"`
+	// false if a get does not find the key
+	Found   bool       `json:"found,omitempty"`
+	Success bool       `json:"success"`
+	Error   *httpError `json:"error,omitempty"`
+}
+
+type httpBatchResponse struct {
+	Results     []httpBatchResult `json:"results"`
+	VectorClock map[string]int32  `json:"vector_clock"`
+	Stale       bool              `json:"stale,omitempty"`
+}
+
+var (
+	errHTTPNotCaughtUp = httpError{Code: "not_caught_up", Message: "this node has not caught up with the vector clock of the request"}
+	errHTTPNotFound    = httpError{Code: "not_found", Message: "key not found"}
+)
+
+// 初始化HTTP Server
+func (kvs *KVServer) RegisterHTTPServer(address string) {
+	util.DPrintf("RegisterHTTPServer: %s", address)
+	mux := http.NewServeMux()
+	mux.HandleFunc(httpKVPrefix, kvs.handleHTTPKV)
+	mux.HandleFunc("/v1/batch", kvs.handleHTTPBatch)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		util.EPrintf("Error HTTP serving: %v", err)
+	}
+}
+
+func writeHTTPJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeHTTPError(w http.ResponseWriter, status int, code string, format string, a ...interface{}) {
+	writeHTTPJSON(w, status, httpErrorBody{Error: httpError{Code: code, Message: fmt.Sprintf(format, a...)}})
+}
+
+func setHTTPVectorClock(w http.ResponseWriter, vc map[string]int32) {
+	data, _ := json.Marshal(vc)
+	w.Header().Set(httpVectorClockHeader, string(data))
+}
+
+// httpConsistency reads ?consistency=, causal by default
+func httpConsistency(r *http.Request, fallback string) (string, error) {
+	level := r.URL.Query().Get("consistency")
+	if level == "" {
+		level = fallback
+	}
+	if level == "" {
+		return ConsistencyCausal, nil
+	}
+	if consistency := parseConsistency(level); consistency != "" {
+		return consistency, nil
+	}
+	return "", fmt.Errorf("unknown consistency level '%s'", level)
+}
+
+// httpVectorClock reads the vectorclock header, nil if it is absent
+func httpVectorClock(r *http.Request) (map[string]int32, error) {
+	header := r.Header.Get(httpVectorClockHeader)
+	if header == "" {
+		return nil, nil
+	}
+	var vc map[string]int32
+	if err := json.Unmarshal([]byte(header), &vc); err != nil {
+		return nil, fmt.Errorf("invalid %s header: %v", httpVectorClockHeader, err)
+	}
+	return vc, nil
+}
+
+// decodeHTTPBody decodes the JSON body into v, an empty body leaves v unchanged
+func decodeHTTPBody(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, httpMaxBody)).Decode(v)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+// GET/PUT/DELETE /v1/kv/{key}, a key containing '/' has to be escaped as %2F
+func (kvs *KVServer) handleHTTPKV(w http.ResponseWriter, r *http.Request) {
+	key, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), httpKVPrefix))
+	if err != nil || key == "" {
+		writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "invalid key in path %s", r.URL.EscapedPath())
+		return
+	}
+	consistency, err := httpConsistency(r, "")
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "%v", err)
+		return
+	}
+	vc, err := httpVectorClock(r)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "%v", err)
+		return
+	}
+	var body httpKVRequest
+	if r.Method == http.MethodPut || r.Method == http.MethodDelete {
+		if err := decodeHTTPBody(w, r, &body); err != nil {
+			writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "invalid body: %v", err)
+			return
+		}
+		if body.VectorClock != nil {
+			vc = body.VectorClock
+		}
+	}
+	util.DPrintf("handleHTTPKV: %s %s %s", r.Method, key, consistency)
+	switch r.Method {
+	case http.MethodGet:
+		value, laggingNodes, newVC, ok := kvs.readKey(consistency, vc, key)
+		if !ok {
+			w.Header().Set("Retry-After", "1")
+			writeHTTPJSON(w, http.StatusServiceUnavailable, httpErrorBody{Error: errHTTPNotCaughtUp})
+			return
+		}
+		setHTTPVectorClock(w, newVC)
+		if value == nil {
+			writeHTTPJSON(w, http.StatusNotFound, httpErrorBody{Error: errHTTPNotFound})
+			return
+		}
+		valueStr := string(value)
+		writeHTTPJSON(w, http.StatusOK, httpKVResponse{
+			Key:          key,
+			Value:        &valueStr,
+			VectorClock:  newVC,
+			Stale:        len(laggingNodes) > 0,
+			LaggingNodes: laggingNodes,
+		})
+	case http.MethodPut:
+		newVC, ok := kvs.writeLog(consistency, vc, config.Log{Option: "Put", Key: key, Value: body.Value, TTL: body.TTL})
+		if !ok {
+			w.Header().Set("Retry-After", "1")
+			writeHTTPJSON(w, http.StatusServiceUnavailable, httpErrorBody{Error: errHTTPNotCaughtUp})
+			return
+		}
+		setHTTPVectorClock(w, newVC)
+		writeHTTPJSON(w, http.StatusOK, httpKVResponse{Key: key, VectorClock: newVC})
+	case http.MethodDelete:
+		deleted := kvs.store.Has(key)
+		newVC, ok := kvs.writeLog(consistency, vc, config.Log{Option: "Delete", Key: key})
+		if !ok {
+			w.Header().Set("Retry-After", "1")
+			writeHTTPJSON(w, http.StatusServiceUnavailable, httpErrorBody{Error: errHTTPNotCaughtUp})
+			return
+		}
+		setHTTPVectorClock(w, newVC)
+		writeHTTPJSON(w, http.StatusOK, httpKVResponse{Key: key, Deleted: &deleted, VectorClock: newVC})
+	default:
+		w.Header().Set("Allow", "GET, PUT, DELETE")
+		writeHTTPError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method %s is not allowed", r.Method)
+	}
+}
+
+// POST /v1/batch, the ops are executed in order and each op sees the vectorclock of the previous ones
+func (kvs *KVServer) handleHTTPBatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		writeHTTPError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method %s is not allowed", r.Method)
+		return
+	}
+	var body httpBatchRequest
+	if err := decodeHTTPBody(w, r, &body); err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "invalid body: %v", err)
+		return
+	}
+	consistency, err := httpConsistency(r, body.Consistency)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "%v", err)
+		return
+	}
+	vc, err := httpVectorClock(r)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "%v", err)
+		return
+	}
+	if body.VectorClock != nil {
+		vc = body.VectorClock
+	}
+	for _, op := range body.Ops {
+		if op.Key == "" {
+			writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "empty key in batch")
+			return
+		}
+		if op.Op != "get" && op.Op != "put" && op.Op != "delete" {
+			writeHTTPError(w, http.StatusBadRequest, "invalid_argument", "unknown op '%s' in batch", op.Op)
+			return
+		}
+	}
+	util.DPrintf("handleHTTPBatch: %d ops %s", len(body.Ops), consistency)
+	resp := httpBatchResponse{Results: make([]httpBatchResult, 0, len(body.Ops))}
+	for _, op := range body.Ops {
+		res := httpBatchResult{Key: op.Key}
+		var ok bool
+		switch op.Op {
+		case "get":
+			var value []byte
+			var laggingNodes []string
+			var newVC map[string]int32
+			value, laggingNodes, newVC, ok = kvs.readKey(consistency, vc, op.Key)
+			if ok {
+				vc = newVC
+				resp.Stale = resp.Stale || len(laggingNodes) > 0
+				if value != nil {
+					valueStr := string(value)
+					res.Value = &valueStr
+					res.Found = true
+				}
+			}
+		case "put":
+			vc, ok = kvs.writeLog(consistency, vc, config.Log{Option: "Put", Key: op.Key, Value: op.Value, TTL: op.TTL})
+		case "delete":
+			vc, ok = kvs.writeLog(consistency, vc, config.Log{Option: "Delete", Key: op.Key})
+		}
+		res.Success = ok
+		if !ok {
+			res.Error = &errHTTPNotCaughtUp
+		}
+		resp.Results = append(resp.Results, res)
+	}
+	resp.VectorClock = kvs.completeClock(vc)
+	setHTTPVectorClock(w, resp.VectorClock)
+	writeHTTPJSON(w, http.StatusOK, resp)
+}
@@ -4,24 +4,37 @@ import (
 	"context"
 	"encoding/json"
 	"flag"
-	"fmt"
 	"math/rand"
 	"net"
 	_ "net/http/pprof"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/JasonLou99/Hybrid_KV_Store/cdc"
 	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/hlc"
 	"github.com/JasonLou99/Hybrid_KV_Store/lattices"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/adminrpc"
 	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
 	"github.com/JasonLou99/Hybrid_KV_Store/rpc/eventualrpc"
 	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/seglog"
+	"github.com/JasonLou99/Hybrid_KV_Store/session"
+	"github.com/JasonLou99/Hybrid_KV_Store/stability"
 	"github.com/JasonLou99/Hybrid_KV_Store/store"
 	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"github.com/JasonLou99/Hybrid_KV_Store/wal"
+	"github.com/JasonLou99/Hybrid_KV_Store/writeless"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type KVServer struct {
@@ -29,7 +42,6 @@ type KVServer struct {
 	address         string
 	internalAddress string // internal address for communication between nodes
 	latency         int    // Simulation of geographical delay
-	logs            []config.Log
 	vectorclock     sync.Map
 	store           *store.Store
 	// memdb           *redis.Client
@@ -40,14 +52,56 @@ type KVServer struct {
 	// variable for writeless
 	// "key": ["node1","node2"], ...
 	// putCountsByNodes sync.Map
-	// "key": 3, ...
+	// "key": 3, ... puts not synced yet, the key is removed once synced
 	putCountsInProxy sync.Map
-	// "key": 5, ...
-	predictPutCounts sync.Map
-	// "key": 3, ...
-	getCountsInTotal sync.Map
-	// "key": 3, ...
-	putCountsInTotal sync.Map
+	// decayed gets and puts of every key, persisted in store and gossiped among peers,
+	// predictPutCounts = cluster puts / cluster gets
+	stats               *writeless.Stats
+	statsGossipInterval time.Duration
+	// optional recorder of the access trace for training, nil if disabled
+	recorder *writeless.Recorder
+	// puts buffered by prediction, flushed by flusher after maxDeferral or beyond maxDeferredBytes
+	deferred         map[string]*deferredPut
+	deferredBytes    int
+	deferredMu       sync.Mutex
+	maxDeferral      time.Duration
+	maxDeferredBytes int
+	flushCh          chan struct{}
+	// a writeless Get pulls deferred puts from the peers, peers slower than pullTimeout are reported as lagging
+	pullDeferredOn bool
+	pullTimeout    time.Duration
+	// default consistency of RESP connections, changed per connection by HYDIS.CONSISTENCY
+	respConsistency string
+	respClients     int32
+	respNextID      int64
+	// encodes the vectorclock of a client into an opaque session token and back
+	sessions *session.Codec
+	// addresses of the other front ends, reported by GetClusterInfo, empty if disabled
+	tcpAddress  string
+	respAddress string
+	httpAddress string
+	// writers of the store hold the lock, a MultiGet reads all keys under the read lock
+	applyMu sync.RWMutex
+	// strong compare-and-sets on the primary are serialized by strongMu
+	strongMu sync.Mutex
+	// HLC timestamps of the versions of local writes
+	clock hlc.Clock
+	// parts of write transactions from other nodes, applied when all parts have arrived
+	pendingTxns map[string]*pendingTxn
+	// ids of the applied transactions from other nodes and when they were applied
+	appliedTxns map[string]time.Time
+	txnMu       sync.Mutex
+	txnPartSize int
+	txnTimeout  time.Duration
+	// change events of the applied writes for Watch
+	watches *watchHub
+	// change data capture of the applied writes, nil if disabled
+	changes *cdc.Writer
+	// write-ahead log of the applied writes, replayed on startup
+	wal *wal.Log
+	// vectorclocks of all nodes and the stable frontier, see stability.go
+	stable              *stability.Tracker
+	clockGossipInterval time.Duration
 }
 
 type ValueTimestamp struct {
@@ -56,30 +110,13 @@ type ValueTimestamp struct {
 	version   int32
 }
 
-// TCP Message struct
-type TCPReq struct {
-	Consistency string           `json:"consistency"`
-	Operation   string           `json:"operation"`
-	Key         string           `json:"key"`
-	Value       string           `json:"value"`
-	VectorClock map[string]int32 `json:"vector_clock"`
-}
-
-type TCPResp struct {
-	Operation   string           `json:"operation"`
-	Key         string           `json:"key"`
-	Value       string           `json:"value"`
-	VectorClock map[string]int32 `json:"vector_clock"`
-	Success     bool             `json:"success"`
-}
-
 // this method is used to execute the command from client with causal consistency
 func (kvs *KVServer) startInCausal(command interface{}, vcFromClientArg map[string]int32, timestampFromClient int64) bool {
 	vcFromClient := util.BecomeSyncMap(vcFromClientArg)
 	newLog := command.(config.Log)
 	util.DPrintf("Log in Start(): %v ", newLog)
 	// util.DPrintf("vcFromClient in Start(): %v", vcFromClient)
-	if newLog.Option == "Put" {
+	if newLog.IsWrite() {
 		/*
 			Put操作中的vectorclock的变更逻辑
 			1. 如果要求kvs.vectorclock更大，那么就无法让client跨越更新本地数据（即client收到了其它节点更新的数据，无法直接更新旧的副本节点）
@@ -114,6 +151,8 @@ func (kvs *KVServer) startInCausal(command interface{}, vcFromClientArg map[stri
 		// kvs.vectorclock = vcFromClient
 		// val, _ := kvs.vectorclock.Load(kvs.internalAddress)
 		// kvs.vectorclock.Store(kvs.internalAddress, val.(int32)+1)
+		// the vectorclock never covers a write which is not applied yet, see readtxn.go
+		kvs.applyMu.Lock()
 		isUpper := util.IsUpper(kvs.vectorclock, vcFromClient)
 		if isUpper {
 			val, _ := kvs.vectorclock.Load(kvs.internalAddress)
@@ -124,6 +163,7 @@ func (kvs *KVServer) startInCausal(command interface{}, vcFromClientArg map[stri
 			val, _ := kvs.vectorclock.Load(kvs.internalAddress)
 			kvs.vectorclock.Store(kvs.internalAddress, val.(int32)+1)
 		}
+		now := kvs.clock.Now()
 		// init MapLattice for sending to other nodes
 		ml := lattices.HybridLattice{
 			Key: newLog.Key,
@@ -131,6 +171,8 @@ func (kvs *KVServer) startInCausal(command interface{}, vcFromClientArg map[stri
 				Log:         newLog,
 				VectorClock: util.BecomeMap(kvs.vectorclock),
 			},
+			HLC:    now,
+			Origin: kvs.internalAddress,
 		}
 		data, _ := json.Marshal(ml)
 		args := &causalrpc.AppendEntriesInCausalRequest{
@@ -148,9 +190,9 @@ func (kvs *KVServer) startInCausal(command interface{}, vcFromClientArg map[stri
 			}
 		}
 		// update value in the db and persist
-		kvs.logs = append(kvs.logs, newLog)
 		// kvs.db.Store(newLog.Key, &ValueTimestamp{value: newLog.Value, timestamp: time.Now().UnixMilli(), version: oldVersion + 1})
-		kvs.store.Put(newLog.Key, newLog.Value)
+		kvs.applyLog(newLog, now)
+		kvs.applyMu.Unlock()
 		// err := kvs.memdb.Set(kvs.ctx, newLog.Key, newLog.Value, 0).Err()
 		// if err != nil {
 		// 	panic(err)
@@ -174,7 +216,11 @@ func (kvs *KVServer) GetInCausal(ctx context.Context, in *kvrpc.GetInCausalReque
 		Key:    in.Key,
 		Value:  "",
 	}
-	ok := kvs.startInCausal(op, in.Vectorclock, in.Timestamp)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ok := kvs.startInCausal(op, vc, in.Timestamp)
 	if ok {
 		/* vt, _ := kvs.db.Load(in.Key)
 		if vt == nil {
@@ -201,9 +247,11 @@ func (kvs *KVServer) GetInCausal(ctx context.Context, in *kvrpc.GetInCausalReque
 		// }
 		// getInCausalResponse.Value = string(val)
 		getInCausalResponse.Success = true
+		getInCausalResponse.Session = kvs.sessionToken()
 	} else {
 		getInCausalResponse.Value = ""
 		getInCausalResponse.Success = false
+		getInCausalResponse.Session = kvs.sessions.Encode(vc)
 	}
 	return getInCausalResponse, nil
 }
@@ -216,7 +264,11 @@ func (kvs *KVServer) PutInCausal(ctx context.Context, in *kvrpc.PutInCausalReque
 		Key:    in.Key,
 		Value:  in.Value,
 	}
-	ok := kvs.startInCausal(op, in.Vectorclock, in.Timestamp)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ok := kvs.startInCausal(op, vc, in.Timestamp)
 	if ok {
 		putInCausalResponse.Success = true
 	} else {
@@ -224,6 +276,7 @@ func (kvs *KVServer) PutInCausal(ctx context.Context, in *kvrpc.PutInCausalReque
 		putInCausalResponse.Success = false
 	}
 	putInCausalResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+	putInCausalResponse.Session = kvs.sessionToken()
 	return putInCausalResponse, nil
 }
 
@@ -233,7 +286,9 @@ func (kvs *KVServer) startInWritelessCausal(command interface{}, vcFromClientArg
 	newLog := command.(config.Log)
 	util.DPrintf("Log in Start(): %v ", newLog)
 	// util.DPrintf("vcFromClient in Start(): %v", vcFromClient)
-	if newLog.Option == "Put" {
+	if newLog.IsWrite() {
+		// the vectorclock never covers a write which is not applied yet, see readtxn.go
+		kvs.applyMu.Lock()
 		isUpper := util.IsUpper(kvs.vectorclock, vcFromClient)
 		if isUpper {
 			val, _ := kvs.vectorclock.Load(kvs.internalAddress)
@@ -244,9 +299,12 @@ func (kvs *KVServer) startInWritelessCausal(command interface{}, vcFromClientArg
 			val, _ := kvs.vectorclock.Load(kvs.internalAddress)
 			kvs.vectorclock.Store(kvs.internalAddress, val.(int32)+1)
 		}
+		now := kvs.clock.Now()
+		kvs.recorder.RecordPut(newLog.Key)
 		putCounts_int := util.LoadInt(kvs.putCountsInProxy, newLog.Key)
-		predictCounts_int := util.LoadInt(kvs.predictPutCounts, newLog.Key)
-		if putCounts_int >= predictCounts_int {
+		predictCounts_int := kvs.stats.Predict(newLog.Key)
+		// only Put is deferred by prediction, Delete and Expire are synced at once
+		if newLog.Option != "Put" || putCounts_int >= predictCounts_int {
 			util.DPrintf("Sync History Puts by Prediction, predictPutCounts: %v, putCountsInProxy: %v", predictCounts_int, putCounts_int)
 			// init MapLattice for sending to other nodes
 			ml := lattices.HybridLattice{
@@ -255,6 +313,8 @@ func (kvs *KVServer) startInWritelessCausal(command interface{}, vcFromClientArg
 					Log:         newLog,
 					VectorClock: util.BecomeMap(kvs.vectorclock),
 				},
+				HLC:    now,
+				Origin: kvs.internalAddress,
 			}
 			data, _ := json.Marshal(ml)
 			args := &causalrpc.AppendEntriesInCausalRequest{
@@ -268,12 +328,15 @@ func (kvs *KVServer) startInWritelessCausal(command interface{}, vcFromClientArg
 					go kvs.sendAppendEntriesInCausal(kvs.peers[i], args)
 				}
 			}
-			kvs.putCountsInProxy.Store(newLog.Key, 0)
+			kvs.putCountsInProxy.Delete(newLog.Key)
+			kvs.clearDeferred(newLog.Key)
+		} else {
+			kvs.deferPut(newLog.Key, newLog.Value)
 		}
 		// update value in the db and persist
-		kvs.logs = append(kvs.logs, newLog)
 		// kvs.db.Store(newLog.Key, &ValueTimestamp{value: newLog.Value, timestamp: time.Now().UnixMilli(), version: oldVersion + 1})
-		kvs.store.Put(newLog.Key, newLog.Value)
+		kvs.applyLog(newLog, now)
+		kvs.applyMu.Unlock()
 		// err := kvs.memdb.Set(kvs.ctx, newLog.Key, newLog.Value, 0).Err()
 		// if err != nil {
 		// 	panic(err)
@@ -283,41 +346,14 @@ func (kvs *KVServer) startInWritelessCausal(command interface{}, vcFromClientArg
 		vcKVS, _ := kvs.vectorclock.Load(kvs.internalAddress)
 		vcKVC, _ := vcFromClient.Load(kvs.internalAddress)
 		if vcKVS.(int32) >= vcKVC.(int32) {
-			getCounts := util.LoadInt(kvs.getCountsInTotal, newLog.Key)
-			// 该Get请求有效
-			kvs.getCountsInTotal.Store(newLog.Key, getCounts+1)
-			totalCounts := util.LoadInt(kvs.putCountsInTotal, newLog.Key)
+			// 该Get请求有效, update stats for predictPutCounts
+			kvs.stats.AddGet(newLog.Key)
+			kvs.recorder.RecordGet(newLog.Key)
 			proxyCounts := util.LoadInt(kvs.putCountsInProxy, newLog.Key)
-			// update predictPutCounts
-			// 取平均
-			kvs.predictPutCounts.Store(newLog.Key, (totalCounts+proxyCounts)/(getCounts+1))
 			if proxyCounts != 0 {
 				util.DPrintf("Sync History Puts by Get")
 				// 同步该key之前的put
-				syncLog := config.Log{
-					Option: "Put",
-					Key:    newLog.Key,
-					Value:  string(kvs.store.Get(newLog.Key)),
-				}
-				ml := lattices.HybridLattice{
-					Key: newLog.Key,
-					Vl: lattices.ValueLattice{
-						Log:         syncLog,
-						VectorClock: util.BecomeMap(kvs.vectorclock),
-					},
-				}
-				data, _ := json.Marshal(ml)
-				syncReq := &causalrpc.AppendEntriesInCausalRequest{
-					MapLattice: data,
-					// Version:    oldVersion + 1,
-					Version: 1,
-				}
-				for i := 0; i < len(kvs.peers); i++ {
-					if kvs.peers[i] != kvs.internalAddress {
-						go kvs.sendAppendEntriesInCausal(kvs.peers[i], syncReq)
-					}
-				}
-				kvs.putCountsInProxy.Store(newLog.Key, 0)
+				kvs.syncHistoryPuts(newLog.Key)
 				// kvs.putCountsByNodes.Store(newLog.Key, nil)
 			}
 			return true
@@ -340,15 +376,25 @@ func (kvs *KVServer) GetInWritelessCausal(ctx context.Context, in *kvrpc.GetInWr
 		Value:  "",
 	}
 	util.DPrintf("GetInWritelessCausal %s", in.Key)
-	ok := kvs.startInWritelessCausal(op, in.Vectorclock, in.Timestamp)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ok := kvs.startInWritelessCausal(op, vc, in.Timestamp)
 	getInWritelessCausalResponse := new(kvrpc.GetInWritelessCausalResponse)
 	if ok {
+		// puts deferred on other nodes are invisible here, pull them before answering
+		laggingNodes := kvs.pullDeferred(in.Key)
+		getInWritelessCausalResponse.Stale = len(laggingNodes) > 0
+		getInWritelessCausalResponse.LaggingNodes = laggingNodes
 		getInWritelessCausalResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
 		getInWritelessCausalResponse.Value = string(kvs.store.Get(in.Key))
 		getInWritelessCausalResponse.Success = true
+		getInWritelessCausalResponse.Session = kvs.sessionToken()
 	} else {
 		getInWritelessCausalResponse.Value = ""
 		getInWritelessCausalResponse.Success = false
+		getInWritelessCausalResponse.Session = kvs.sessions.Encode(vc)
 	}
 	return getInWritelessCausalResponse, nil
 }
@@ -364,12 +410,15 @@ func (kvs *KVServer) PutInWritelessCausal(ctx context.Context, in *kvrpc.PutInWr
 		Key:    in.Key,
 		Value:  in.Value,
 	}
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	proxyCounts := util.LoadInt(kvs.putCountsInProxy, in.Key)
 	kvs.putCountsInProxy.Store(in.Key, proxyCounts+1)
-	totalCounts := util.LoadInt(kvs.putCountsInTotal, in.Key)
-	kvs.putCountsInTotal.Store(in.Key, totalCounts+1)
+	kvs.stats.AddPut(in.Key)
 	// kvs.putCountsByNodes[in.Key] = append(kvs.putCountsByNodes[in.Key], kvs.internalAddress)
-	ok := kvs.startInWritelessCausal(op, in.Vectorclock, in.Timestamp)
+	ok := kvs.startInWritelessCausal(op, vc, in.Timestamp)
 	if ok {
 		putInWritelessCausalResponse.Success = true
 	} else {
@@ -377,14 +426,52 @@ func (kvs *KVServer) PutInWritelessCausal(ctx context.Context, in *kvrpc.PutInWr
 		putInWritelessCausalResponse.Success = false
 	}
 	putInWritelessCausalResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+	putInWritelessCausalResponse.Session = kvs.sessionToken()
 	return putInWritelessCausalResponse, nil
 }
 
+func (kvs *KVServer) DeleteInCausal(ctx context.Context, in *kvrpc.DeleteInCausalRequest) (*kvrpc.DeleteInCausalResponse, error) {
+	util.DPrintf("DeleteInCausal %s", in.Key)
+	deleteInCausalResponse := new(kvrpc.DeleteInCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	op := config.Log{
+		Option: "Delete",
+		Key:    in.Key,
+	}
+	deleteInCausalResponse.Success = kvs.startInCausal(op, vc, in.Timestamp)
+	deleteInCausalResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+	deleteInCausalResponse.Session = kvs.sessionToken()
+	return deleteInCausalResponse, nil
+}
+
+// a delete is never deferred by prediction, it is synced at once
+func (kvs *KVServer) DeleteInWritelessCausal(ctx context.Context, in *kvrpc.DeleteInWritelessCausalRequest) (*kvrpc.DeleteInWritelessCausalResponse, error) {
+	util.DPrintf("DeleteInWritelessCausal %s", in.Key)
+	deleteInWritelessCausalResponse := new(kvrpc.DeleteInWritelessCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	op := config.Log{
+		Option: "Delete",
+		Key:    in.Key,
+	}
+	deleteInWritelessCausalResponse.Success = kvs.startInWritelessCausal(op, vc, in.Timestamp)
+	deleteInWritelessCausalResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+	deleteInWritelessCausalResponse.Session = kvs.sessionToken()
+	return deleteInWritelessCausalResponse, nil
+}
+
 func (kvs *KVServer) startInEventual(command interface{}, vcFromClientArg map[string]int32, timestampFromClient int64) bool {
 	vcFromClient := util.BecomeSyncMap(vcFromClientArg)
 	newLog := command.(config.Log)
 	util.DPrintf("Log in Start(): %v ", newLog)
-	if newLog.Option == "Put" {
+	if newLog.IsWrite() {
+		// the vectorclock never covers a write which is not applied yet, see readtxn.go
+		kvs.applyMu.Lock()
 		isUpper := util.IsUpper(kvs.vectorclock, vcFromClient)
 		if isUpper {
 			val, _ := kvs.vectorclock.Load(kvs.internalAddress)
@@ -395,6 +482,7 @@ func (kvs *KVServer) startInEventual(command interface{}, vcFromClientArg map[st
 			val, _ := kvs.vectorclock.Load(kvs.internalAddress)
 			kvs.vectorclock.Store(kvs.internalAddress, val.(int32)+1)
 		}
+		now := kvs.clock.Now()
 		val, _ := vcFromClient.Load(kvs.internalAddress)
 		kvs.vectorclock.Store(kvs.internalAddress, val.(int32)+1)
 		// Sync
@@ -404,6 +492,8 @@ func (kvs *KVServer) startInEventual(command interface{}, vcFromClientArg map[st
 				Log:         newLog,
 				VectorClock: util.BecomeMap(kvs.vectorclock),
 			},
+			HLC:    now,
+			Origin: kvs.internalAddress,
 		}
 		data, _ := json.Marshal(ml)
 		args := &eventualrpc.AppendEntriesInEventualRequest{
@@ -417,7 +507,9 @@ func (kvs *KVServer) startInEventual(command interface{}, vcFromClientArg map[st
 				go kvs.sendAppendEntriesInEventual(kvs.peers[i], args)
 			}
 		}
-
+		kvs.applyLog(newLog, now)
+		kvs.applyMu.Unlock()
+		return true
 	} else if newLog.Option == "Get" {
 		return true
 	}
@@ -430,20 +522,81 @@ func (kvs *KVServer) AppendEntriesInCausal(ctx context.Context, in *causalrpc.Ap
 	appendEntriesInCausalResponse := &causalrpc.AppendEntriesInCausalResponse{}
 	var mlFromOther lattices.HybridLattice
 	json.Unmarshal(in.MapLattice, &mlFromOther)
+	kvs.observePeerClock(mlFromOther.Origin, mlFromOther.Vl.VectorClock)
+	if mlFromOther.Txn != "" {
+		// a part of a write transaction waits for the other parts
+		txn, complete, ok := kvs.bufferTxnPart(mlFromOther)
+		if complete {
+			ok = kvs.applyTxnInCausal(txn)
+		}
+		appendEntriesInCausalResponse.Success = ok
+		return appendEntriesInCausalResponse, nil
+	}
+	// Reject the log if it is not newer, Because of vectorclock
+	appendEntriesInCausalResponse.Success = kvs.applyLatticeInCausal(mlFromOther)
+	return appendEntriesInCausalResponse, nil
+}
+
+// apply the lattice from other node if its vectorclock is not covered by kvs.vectorclock
+func (kvs *KVServer) applyLatticeInCausal(mlFromOther lattices.HybridLattice) bool {
 	vcFromOther := util.BecomeSyncMap(mlFromOther.Vl.VectorClock)
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
 	ok := util.IsUpper(kvs.vectorclock, vcFromOther)
-	if !ok {
-		// Append the log to the local log
-		kvs.logs = append(kvs.logs, mlFromOther.Vl.Log)
-		// kvs.db.Store(mlFromOther.Key, &ValueTimestamp{value: mlFromOther.Vl.Log.Value, timestamp: time.Now().UnixMilli(), version: in.Version})
-		kvs.store.Put(mlFromOther.Key, mlFromOther.Vl.Log.Value)
-		kvs.MergeVC(vcFromOther)
-		appendEntriesInCausalResponse.Success = true
-	} else {
-		// Reject the log, Because of vectorclock
-		appendEntriesInCausalResponse.Success = false
+	if ok {
+		return false
+	}
+	logs := mlFromOther.Logs()
+	// kvs.db.Store(mlFromOther.Key, &ValueTimestamp{value: mlFromOther.Vl.Log.Value, timestamp: time.Now().UnixMilli(), version: in.Version})
+	kvs.applyLogsLocked(logs, mlFromOther.Vl.VectorClock, kvs.remoteTime(mlFromOther.HLC), mlFromOther.Origin)
+	kvs.MergeVC(vcFromOther)
+	return true
+}
+
+// remoteTime advances the HLC of this node past the timestamp of a write from another node, and returns the timestamp.
+// lattices of nodes without HLC get a local timestamp
+func (kvs *KVServer) remoteTime(ts int64) int64 {
+	if ts == 0 {
+		return kvs.clock.Now()
+	}
+	kvs.clock.Update(ts)
+	return ts
+}
+
+// applyLog executes a local write log on the store, its version is the current vectorclock and ts.
+// The caller holds applyMu since it incremented the vectorclock
+func (kvs *KVServer) applyLog(log config.Log, ts int64) {
+	kvs.applyLogsLocked([]config.Log{log}, util.BecomeMap(kvs.vectorclock), ts, kvs.internalAddress)
+}
+
+// applyLogsLocked requires applyMu, the logs of vc are executed as one step, a MultiGet sees all or none of them.
+// vc and the HLC timestamp ts become the version of the written keys in the history of the store,
+// origin is the node which accepted the writes
+func (kvs *KVServer) applyLogsLocked(logs []config.Log, vc map[string]int32, ts int64, origin string) {
+	kvs.writeAhead(logs, vc, ts, origin)
+	kvs.applyToStore(logs, vc, ts)
+	kvs.watches.publish(logs, vc, ts)
+	kvs.capture(logs, vc, ts, origin)
+}
+
+// applyToStore executes the logs on the store, it is also used by the replay of the write-ahead log
+func (kvs *KVServer) applyToStore(logs []config.Log, vc map[string]int32, ts int64) {
+	for _, log := range logs {
+		switch log.Option {
+		case "Delete":
+			kvs.store.AddVersion(log.Key, nil, true, vc, ts)
+			kvs.store.Delete(log.Key)
+		case "Expire":
+			if kvs.store.Has(log.Key) {
+				kvs.store.AddVersion(log.Key, kvs.store.Get(log.Key), false, vc, ts)
+			}
+			seconds, _ := strconv.Atoi(log.Value)
+			kvs.store.Expire(log.Key, seconds)
+		default:
+			kvs.store.AddVersion(log.Key, []byte(log.Value), false, vc, ts)
+			kvs.store.PutTTL(log.Key, log.Value, log.TTL)
+		}
 	}
-	return appendEntriesInCausalResponse, nil
 }
 
 func (kvs *KVServer) AppendEntriesInEventual(ctx context.Context, in *eventualrpc.AppendEntriesInEventualRequest) (*eventualrpc.AppendEntriesInEventualResponse, error) {
@@ -451,13 +604,15 @@ func (kvs *KVServer) AppendEntriesInEventual(ctx context.Context, in *eventualrp
 	appendEntriesInEventualResponse := &eventualrpc.AppendEntriesInEventualResponse{}
 	var mlFromOther lattices.HybridLattice
 	json.Unmarshal(in.MapLattice, &mlFromOther)
+	kvs.observePeerClock(mlFromOther.Origin, mlFromOther.Vl.VectorClock)
 	vcFromOther := util.BecomeSyncMap(mlFromOther.Vl.VectorClock)
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
 	ok := util.IsUpper(kvs.vectorclock, vcFromOther)
 	if !ok {
-		// Append the log to the local log
-		kvs.logs = append(kvs.logs, mlFromOther.Vl.Log)
+		logs := mlFromOther.Logs()
 		// kvs.db.Store(mlFromOther.Key, &ValueTimestamp{value: mlFromOther.Vl.Log.Value, timestamp: time.Now().UnixMilli(), version: in.Version})
-		kvs.store.Put(mlFromOther.Key, mlFromOther.Vl.Log.Value)
+		kvs.applyLogsLocked(logs, mlFromOther.Vl.VectorClock, kvs.remoteTime(mlFromOther.HLC), mlFromOther.Origin)
 		kvs.MergeVC(vcFromOther)
 		appendEntriesInEventualResponse.Success = true
 	} else {
@@ -475,6 +630,7 @@ func (kvs *KVServer) RegisterKVServer(address string) {
 		}
 		grpcServer := grpc.NewServer()
 		kvrpc.RegisterKVServer(grpcServer, kvs)
+		adminrpc.RegisterADMINServer(grpcServer, kvs)
 		reflection.Register(grpcServer)
 		if err := grpcServer.Serve(lis); err != nil {
 			util.FPrintf("failed to serve: %v", err)
@@ -500,7 +656,7 @@ func (kvs *KVServer) RegisterCausalServer(address string) {
 
 // s0 --> other servers
 func (kvs *KVServer) sendAppendEntriesInCausal(address string, args *causalrpc.AppendEntriesInCausalRequest) (*causalrpc.AppendEntriesInCausalResponse, bool) {
-	util.DPrintf("here is sendAppendEntriesInCausal() ---------> ", address)
+	util.DPrintf("here is sendAppendEntriesInCausal() ---------> %s", address)
 	// 随机等待，模拟延迟
 	time.Sleep(time.Millisecond * time.Duration(kvs.latency+rand.Intn(25)))
 	// conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithBlock())
@@ -516,14 +672,14 @@ func (kvs *KVServer) sendAppendEntriesInCausal(address string, args *causalrpc.A
 
 	reply, err := client.AppendEntriesInCausal(ctx, args)
 	if err != nil {
-		util.EPrintf("sendAppendEntriesInCausal could not greet: ", err, address)
+		util.EPrintf("sendAppendEntriesInCausal could not greet: %v %v", err, address)
 		return reply, false
 	}
 	return reply, true
 }
 
 func (kvs *KVServer) sendAppendEntriesInEventual(address string, args *eventualrpc.AppendEntriesInEventualRequest) (*eventualrpc.AppendEntriesInEventualResponse, bool) {
-	util.DPrintf("here is sendAppendEntriesInEventual() ---------> ", address)
+	util.DPrintf("here is sendAppendEntriesInEventual() ---------> %s", address)
 	// 随机等待，模拟延迟
 	time.Sleep(time.Millisecond * time.Duration(kvs.latency+rand.Intn(25)))
 	// conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithBlock())
@@ -539,7 +695,7 @@ func (kvs *KVServer) sendAppendEntriesInEventual(address string, args *eventualr
 
 	reply, err := client.AppendEntriesInEventual(ctx, args)
 	if err != nil {
-		util.EPrintf("sendAppendEntriesInEventual could not greet: ", err, address)
+		util.EPrintf("sendAppendEntriesInEventual could not greet: %v %v", err, address)
 		return reply, false
 	}
 	return reply, true
@@ -559,14 +715,19 @@ func (kvs *KVServer) MergeVC(vc sync.Map) {
 	})
 }
 
-func MakeKVServer(address string, internalAddress string, peers []string) *KVServer {
+func MakeKVServer(address string, internalAddress string, peers []string, dbPath string, storeOptions store.Options) *KVServer {
 	util.IPrintf("Make KVServer %s... ", config.Address)
 	kvs := new(KVServer)
 	kvs.store = new(store.Store)
-	kvs.store.Init("db")
+	if err := kvs.store.Open(dbPath, storeOptions); err != nil {
+		// a failed engine must not leave the node serving from the cache alone
+		util.FPrintf("failed to open the store in %s: %v", dbPath, err)
+		os.Exit(1)
+	}
 	kvs.address = address
 	kvs.internalAddress = internalAddress
 	kvs.peers = peers
+	kvs.sessions = session.NewCodec(peers)
 	// init vectorclock: { "192.168.10.120:30881":0, "192.168.10.121:30881":0, ... }
 	for i := 0; i < len(peers); i++ {
 		kvs.vectorclock.Store(peers[i], int32(0))
@@ -579,6 +740,14 @@ func MakeKVServer(address string, internalAddress string, peers []string) *KVSer
 	// 	DB:       0,  // use default DB
 	// })
 	kvs.ctx = context.Background()
+	kvs.deferred = make(map[string]*deferredPut)
+	kvs.flushCh = make(chan struct{}, 1)
+	kvs.pendingTxns = make(map[string]*pendingTxn)
+	kvs.appliedTxns = make(map[string]time.Time)
+	kvs.txnPartSize = defaultTxnPartSize
+	kvs.txnTimeout = defaultTxnTimeout
+	kvs.stable = stability.NewTracker(peers)
+	kvs.clockGossipInterval = defaultClockGossipInterval
 	// 初始化map
 	// kvs.putCountsByNodes = make(map[string][]string)
 	// kvs.putCountsInProxy = make(map[string]int)
@@ -588,210 +757,179 @@ func MakeKVServer(address string, internalAddress string, peers []string) *KVSer
 	return kvs
 }
 
-// 初始化TCP Server
-func (kvs *KVServer) RegisterTCPServer(address string) {
-	util.DPrintf("RegisterTCPServer: %s", address)
-	listener, err := net.Listen("tcp", address)
-	if err != nil {
-		fmt.Println("Error Native TCP listening", err.Error())
-		return // 终止程序
-	}
-	// 监听并接受来自客户端的连接
-	for {
-		conn, err := listener.Accept()
-		if err != nil {
-			fmt.Println("Error accepting", err.Error())
-			return // 终止程序
-		}
-		// 处理连接
-		go kvs.disributeRPC(conn)
-	}
-}
-
-func (kvs *KVServer) disributeRPC(conn net.Conn) {
-	for {
-		buf := make([]byte, 512)
-		length, err := conn.Read(buf)
-		if err != nil {
-			// fmt.Println("Error reading", err.Error())
-			return //终止程序
-		}
-		fmt.Printf("%v\n", string(buf[:length]))
-		var message TCPReq
-		json.Unmarshal(buf[:length], &message)
-		fmt.Printf("message.consistency: %v", string(message.Consistency))
-		consistencyLevel := message.Consistency
-		switch consistencyLevel {
-		case "GetInWritelessCausal":
-			key := message.Key
-			vc := message.VectorClock
-			ts := time.Now().UnixMicro()
-			util.DPrintf("GetInWritelessCausal: %s", key)
-			op := config.Log{
-				Option: "Get",
-				Key:    key,
-				Value:  "",
-			}
-			ok := kvs.startInWritelessCausal(op, vc, ts)
-			var tcpResp TCPResp
-			if ok {
-				tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
-				tcpResp.Value = string(kvs.store.Get(key))
-				tcpResp.Success = true
-				tcpResp.Key = key
-			} else {
-				tcpResp.Value = ""
-				tcpResp.Success = false
-			}
-			res, _ := json.Marshal(tcpResp)
-			conn.Write([]byte(res))
-		case "PutInWritelessCausal":
-			key := message.Key
-			value := message.Value
-			vc := message.VectorClock
-			ts := time.Now().UnixMicro()
-			util.DPrintf("PutInWritelessCausal: key:%s, val:%s, vc:%s, ts:%v", key, value, vc, ts)
-			// conn.Write([]byte("OK"))
-			op := config.Log{
-				Option: message.Operation,
-				Key:    key,
-				Value:  value,
-			}
-			// 更新计数， 比较预测值判断是否需要同步
-			proxyCounts := util.LoadInt(kvs.putCountsInProxy, key)
-			kvs.putCountsInProxy.Store(key, proxyCounts+1)
-			totalCounts := util.LoadInt(kvs.putCountsInTotal, key)
-			kvs.putCountsInTotal.Store(key, totalCounts+1)
-			// 以WritelessCausal一致性级别执行该请求
-			ok := kvs.startInWritelessCausal(op, vc, ts)
-			var tcpResp TCPResp
-			tcpResp.Operation = op.Option
-			tcpResp.Key = op.Key
-			tcpResp.Value = op.Value
-			if ok {
-				tcpResp.Success = true
-			} else {
-				util.DPrintf("PutInWritelessCausal: StartInWritelessCausal Failed key=%s value=%s, Because vcFromClient < kvs.vectorclock", key, value)
-				tcpResp.Success = false
-			}
-			tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
-			res, _ := json.Marshal(tcpResp)
-			conn.Write([]byte(res))
-		case "GetInCausal":
-			key := message.Key
-			vc := message.VectorClock
-			ts := time.Now().UnixMicro()
-			util.DPrintf("GetInCausal: %s", key)
-			op := config.Log{
-				Option: "Get",
-				Key:    key,
-				Value:  "",
-			}
-			ok := kvs.startInCausal(op, vc, ts)
-			var tcpResp TCPResp
-			if ok {
-				tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
-				tcpResp.Value = string(kvs.store.Get(key))
-				tcpResp.Success = true
-				tcpResp.Key = key
-			} else {
-				tcpResp.Value = ""
-				tcpResp.Success = false
-			}
-			res, _ := json.Marshal(tcpResp)
-			conn.Write([]byte(res))
-		case "PutInCausal":
-			key := message.Key
-			value := message.Value
-			vc := message.VectorClock
-			ts := time.Now().UnixMicro()
-			util.DPrintf("PutInCausal: key:%s, val:%s, vc:%s, ts:%v", key, value, vc, ts)
-			op := config.Log{
-				Option: message.Operation,
-				Key:    key,
-				Value:  value,
-			}
-			ok := kvs.startInCausal(op, vc, ts)
-			var tcpResp TCPResp
-			tcpResp.Operation = op.Option
-			tcpResp.Key = op.Key
-			tcpResp.Value = op.Value
-			if ok {
-				tcpResp.Success = true
-			} else {
-				util.DPrintf("PutInEventual: StartInEventual Failed key=%s value=%s, Because vcFromClient < kvs.vectorclock", key, value)
-				tcpResp.Success = false
-			}
-			tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
-			res, _ := json.Marshal(tcpResp)
-			conn.Write([]byte(res))
-		case "GetInEventual":
-			key := message.Key
-			vc := message.VectorClock
-			ts := time.Now().UnixMicro()
-			util.DPrintf("GetInEventual: %s", key)
-			op := config.Log{
-				Option: "Get",
-				Key:    key,
-				Value:  "",
-			}
-			ok := kvs.startInEventual(op, vc, ts)
-			var tcpResp TCPResp
-			if ok {
-				tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
-				tcpResp.Value = string(kvs.store.Get(key))
-				tcpResp.Success = true
-				tcpResp.Key = key
-			} else {
-				tcpResp.Value = ""
-				tcpResp.Success = false
-			}
-			res, _ := json.Marshal(tcpResp)
-			conn.Write([]byte(res))
-		case "PutInEventual":
-			key := message.Key
-			value := message.Value
-			vc := message.VectorClock
-			ts := time.Now().UnixMicro()
-			util.DPrintf("PutInEventual: key:%s, val:%s, vc:%s, ts:%v", key, value, vc, ts)
-			op := config.Log{
-				Option: message.Operation,
-				Key:    key,
-				Value:  value,
-			}
-			ok := kvs.startInEventual(op, vc, ts)
-			var tcpResp TCPResp
-			tcpResp.Operation = op.Option
-			tcpResp.Key = op.Key
-			tcpResp.Value = op.Value
-			if ok {
-				tcpResp.Success = true
-			} else {
-				util.DPrintf("PutInEventual: StartInEventual Failed key=%s value=%s, Because vcFromClient < kvs.vectorclock", key, value)
-				tcpResp.Success = false
-			}
-			tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
-			res, _ := json.Marshal(tcpResp)
-			conn.Write([]byte(res))
-		}
-	}
-}
-
 func main() {
 	// peers inputed by command line
 	var internalAddress_arg = flag.String("internalAddress", "", "Input Your address")
 	var address_arg = flag.String("address", "", "Input Your address")
 	var peers_arg = flag.String("peers", "", "Input Your Peers")
 	var tcpAddress_arg = flag.String("tcpAddress", "", "Input Your TCP address")
+	var dbPath_arg = flag.String("dbPath", "db", "Directory of the durable data of this node")
+	var maxDeferral_arg = flag.Duration("maxDeferral", time.Second, "Max time a writeless put may stay unsynced, 0 means no limit")
+	var maxDeferredBytes_arg = flag.Int("maxDeferredBytes", 4*1024*1024, "Max bytes of the latest values of unsynced writeless keys, 0 means no limit")
+	var pullDeferred_arg = flag.Bool("pullDeferred", false, "Writeless Get pulls the deferred puts of the key from all peers, a synchronous round trip per Get; without it the Get only reports the peers known to lag")
+	var pullTimeout_arg = flag.Duration("pullTimeout", 500*time.Millisecond, "Timeout of pulling deferred puts from a peer")
+	var statsHalfLife_arg = flag.Duration("statsHalfLife", 10*time.Minute, "Half-life of the writeless access statistics")
+	var statsMaxKeys_arg = flag.Int("statsMaxKeys", 100000, "Max keys kept in the writeless access statistics")
+	var statsGossipInterval_arg = flag.Duration("statsGossipInterval", 5*time.Second, "Interval of persisting and gossiping the writeless access statistics")
+	var traceDir_arg = flag.String("traceDir", "", "Directory of the recorded writeless access traces, empty disables the recorder")
+	var traceSample_arg = flag.Float64("traceSample", 1, "Fraction of keys recorded in the access traces")
+	var tracePrefixes_arg = flag.String("tracePrefixes", "", "Comma separated key prefixes recorded in the access traces, empty means all")
+	var traceKeyRegexp_arg = flag.String("traceKeyRegexp", "", "Regexp of keys recorded in the access traces, empty means all")
+	var traceMaxKeys_arg = flag.Int("traceMaxKeys", 10000, "Max keys recorded in the access traces")
+	var httpAddress_arg = flag.String("httpAddress", "", "Input Your HTTP gateway address, empty disables it")
+	var respAddress_arg = flag.String("respAddress", "", "Input Your Redis protocol address, empty disables it")
+	var respConsistency_arg = flag.String("respConsistency", ConsistencyCausal, "Default consistency of Redis protocol connections: causal, writeless-causal or eventual")
+	var traceFlushInterval_arg = flag.Duration("traceFlushInterval", 10*time.Second, "Interval of writing the access traces")
+	var maxVersions_arg = flag.Int("maxVersions", store.DefaultMaxVersions, "Versions kept per key, 0 means no limit")
+	var maxHistoryBytes_arg = flag.Int64("maxHistoryBytes", store.DefaultMaxHistoryBytes, "Memory of the versions kept for all keys, the keys written longest ago lose their versions first, 0 means no limit")
+	var watchBuffer_arg = flag.Int("watchBuffer", defaultWatchBuffer, "Events kept for resuming watchers")
+	var cdcDir_arg = flag.String("cdcDir", "", "Directory of the change data capture log, empty disables it")
+	var cdcSegmentBytes_arg = flag.Int64("cdcSegmentBytes", 64*1024*1024, "Size of a segment of the change data capture log")
+	var cdcMaxSegments_arg = flag.Int("cdcMaxSegments", 0, "Segments of the change data capture log kept, 0 keeps all")
+	var walDir_arg = flag.String("walDir", "", "Directory of the write-ahead log, empty means <dbPath>/wal")
+	var walSync_arg = flag.String("walSync", "batch", "When the write-ahead log is fsynced: always (every write), batch (every second) or none")
+	var walSegmentBytes_arg = flag.Int64("walSegmentBytes", 64*1024*1024, "Size of a segment of the write-ahead log")
+	var restore_arg = flag.String("restore", "", "Snapshot file loaded at startup, the write-ahead log of the node is moved aside")
+	var bootstrapFrom_arg = flag.String("bootstrapFrom", "", "kvrpc address of a node whose snapshot is loaded at startup, the node has to be empty")
+	var clockGossipInterval_arg = flag.Duration("clockGossipInterval", defaultClockGossipInterval, "Interval of gossiping the vectorclock for the stable frontier")
+	var versionRetention_arg = flag.Duration("versionRetention", 0, "Time a replaced version is kept, 0 means no time limit")
+	var txnPartSize_arg = flag.Int("txnPartSize", defaultTxnPartSize, "Writes per replicated part of a write transaction")
+	var txnTimeout_arg = flag.Duration("txnTimeout", defaultTxnTimeout, "Time an incomplete write transaction from a peer is buffered")
+	var storeEngine_arg = flag.String("storeEngine", store.EngineTiered, "tiered (freecache over a persistent leveldb engine) or memory (freecache only, keys expire after 60s)")
+	var cacheSize_arg = flag.Int("cacheSize", store.DefaultCacheBytes/1024/1024, "Size of the freecache cache in MB")
+	var cacheAdmission_arg = flag.String("cacheAdmission", store.AdmitAlways, "Keys put into the cache by the tiered store: always or second-hit (on the second access)")
 	flag.Parse()
 	internalAddress := *internalAddress_arg
 	tcpAddress := *tcpAddress_arg
 	address := *address_arg
 	peers := strings.Split(*peers_arg, ",")
-	kvs := MakeKVServer(address, internalAddress, peers)
+	storeOptions := store.Options{
+		Engine:     *storeEngine_arg,
+		CacheBytes: *cacheSize_arg * 1024 * 1024,
+		Admission:  *cacheAdmission_arg,
+	}
+	kvs := MakeKVServer(address, internalAddress, peers, *dbPath_arg, storeOptions)
+	kvs.maxDeferral = *maxDeferral_arg
+	kvs.maxDeferredBytes = *maxDeferredBytes_arg
+	kvs.pullDeferredOn = *pullDeferred_arg
+	kvs.pullTimeout = *pullTimeout_arg
+	kvs.tcpAddress = tcpAddress
+	kvs.httpAddress = *httpAddress_arg
+	kvs.respAddress = *respAddress_arg
+	kvs.stats = writeless.NewStats(*statsHalfLife_arg, *statsMaxKeys_arg, 3*(*statsGossipInterval_arg))
+	kvs.statsGossipInterval = *statsGossipInterval_arg
+	kvs.store.SetRetention(*maxVersions_arg, *maxHistoryBytes_arg, *versionRetention_arg)
+	if *versionRetention_arg > 0 {
+		go kvs.versionGC(*versionRetention_arg)
+	}
+	kvs.watches = newWatchHub(*watchBuffer_arg)
+	if *cdcDir_arg != "" {
+		changes, err := cdc.Open(*cdcDir_arg, *cdcSegmentBytes_arg, *cdcMaxSegments_arg)
+		if err != nil {
+			util.FPrintf("failed to open the cdc log: %v", err)
+			os.Exit(1)
+		}
+		kvs.changes = changes
+	}
+	walSync, err := seglog.ParseSyncPolicy(*walSync_arg)
+	if err != nil {
+		util.FPrintf("unknown walSync: %s", *walSync_arg)
+		os.Exit(1)
+	}
+	walDir := *walDir_arg
+	if walDir == "" {
+		walDir = filepath.Join(*dbPath_arg, "wal")
+	}
+	// the snapshot is read and verified completely before anything of the node is touched
+	var staged *stagedSnapshot
+	if *restore_arg != "" && *bootstrapFrom_arg != "" {
+		util.FPrintf("-restore and -bootstrapFrom exclude each other")
+		os.Exit(1)
+	}
+	if *restore_arg != "" {
+		if staged, err = kvs.stageFile(*restore_arg); err != nil {
+			util.FPrintf("failed to restore %s: %v", *restore_arg, err)
+			os.Exit(1)
+		}
+		if err := moveAside(walDir); err != nil {
+			util.FPrintf("failed to move the write-ahead log aside: %v", err)
+			os.Exit(1)
+		}
+		if aside, err := kvs.store.MoveAside(); err != nil {
+			util.FPrintf("failed to move the stored keys aside: %v", err)
+			os.Exit(1)
+		} else if aside != "" {
+			util.IPrintf("The stored keys are moved to %s before the restore", aside)
+		}
+	}
+	if *bootstrapFrom_arg != "" {
+		if staged, err = kvs.stageBootstrap(*bootstrapFrom_arg); err != nil {
+			util.FPrintf("failed to bootstrap from %s: %v", *bootstrapFrom_arg, err)
+			os.Exit(1)
+		}
+	}
+	if err := kvs.openWAL(walDir, seglog.Options{SegmentBytes: *walSegmentBytes_arg, Sync: walSync}); err != nil {
+		util.FPrintf("failed to open the write-ahead log: %v", err)
+		os.Exit(1)
+	}
+	if kvs.store.Durable() {
+		kvs.stable.Subscribe(kvs.truncateWAL)
+	} else {
+		// the log is the only copy of the writes of a memory store, the truncated ones would be lost on restart
+		util.IPrintf("The write-ahead log is not truncated, the store engine is not durable")
+	}
+	if staged != nil {
+		if _, err := kvs.loadSnapshot(staged); err != nil {
+			util.FPrintf("failed to load the snapshot: %v", err)
+			os.Exit(1)
+		}
+	}
+	kvs.clockGossipInterval = *clockGossipInterval_arg
+	go kvs.clockGossipLoop()
+	kvs.txnPartSize = *txnPartSize_arg
+	kvs.txnTimeout = *txnTimeout_arg
+	kvs.respConsistency = parseConsistency(*respConsistency_arg)
+	if kvs.respConsistency == "" {
+		util.FPrintf("unknown respConsistency: %s", *respConsistency_arg)
+		os.Exit(1)
+	}
+	kvs.loadStats()
+	go kvs.statsLoop()
+	if *traceDir_arg != "" {
+		recorderConfig := writeless.RecorderConfig{
+			Dir:           *traceDir_arg,
+			SampleRate:    *traceSample_arg,
+			MaxKeys:       *traceMaxKeys_arg,
+			FlushInterval: *traceFlushInterval_arg,
+		}
+		if *tracePrefixes_arg != "" {
+			recorderConfig.KeyPrefixes = strings.Split(*tracePrefixes_arg, ",")
+		}
+		var err error
+		if *traceKeyRegexp_arg != "" {
+			recorderConfig.KeyPattern, err = regexp.Compile(*traceKeyRegexp_arg)
+		}
+		var recorder *writeless.Recorder
+		if err == nil {
+			recorder, err = writeless.NewRecorder(recorderConfig)
+		}
+		if err != nil {
+			util.FPrintf("failed to start the trace recorder: %v", err)
+		} else {
+			kvs.recorder = recorder
+			go kvs.recorder.Run()
+		}
+	}
+	go kvs.flusher()
 	go kvs.RegisterKVServer(kvs.address)
 	go kvs.RegisterCausalServer(kvs.internalAddress)
 	go kvs.RegisterTCPServer(tcpAddress)
+	if kvs.httpAddress != "" {
+		go kvs.RegisterHTTPServer(kvs.httpAddress)
+	}
+	if kvs.respAddress != "" {
+		go kvs.RegisterRESPServer(kvs.respAddress)
+	}
 	// log.Println(http.ListenAndServe(":6060", nil))
 	// server run for 20min
 	time.Sleep(time.Second * 1200)
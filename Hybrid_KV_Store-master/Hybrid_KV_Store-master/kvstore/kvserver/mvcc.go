@@ -0,0 +1,80 @@
+package main
+
+/*
+	多版本读取
+	GetAt按时间(HLC)读取key在本节点上的版本，History返回key的所有保留的版本，用来排查因果异常和恢复错误的写
+	-versionRetention 打开之后，后台定期丢弃被替换超过保留时间的版本
+*/
+
+import (
+	"context"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/hlc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/store"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+// versionGC discards the versions out of the retention window, runs forever
+func (kvs *KVServer) versionGC(retention time.Duration) {
+	interval := retention / 4
+	if interval < time.Second {
+		interval = time.Second
+	}
+	if interval > time.Minute {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if discarded := kvs.store.GC(time.Now()); discarded > 0 {
+			util.DPrintf("versionGC discarded %d versions", discarded)
+		}
+	}
+}
+
+func (kvs *KVServer) GetAt(ctx context.Context, in *kvrpc.GetAtRequest) (*kvrpc.GetAtResponse, error) {
+	util.DPrintf("GetAt %s %d %d", in.Key, in.Timestamp, in.Hlc)
+	getAtResponse := new(kvrpc.GetAtResponse)
+	ts := in.Hlc
+	if ts == 0 {
+		ts = hlc.FromTime(time.UnixMilli(in.Timestamp))
+	}
+	kvs.applyMu.RLock()
+	v, found, err := kvs.store.VersionAtTime(in.Key, ts)
+	kvs.applyMu.RUnlock()
+	if err == store.ErrSnapshotTooOld {
+		getAtResponse.HistoryExpired = true
+		return getAtResponse, nil
+	}
+	getAtResponse.Found = found
+	if found {
+		getAtResponse.Value = string(v.Value)
+	}
+	getAtResponse.Version = v.VectorClock
+	getAtResponse.Hlc = v.Timestamp
+	return getAtResponse, nil
+}
+
+func (kvs *KVServer) History(ctx context.Context, in *kvrpc.HistoryRequest) (*kvrpc.HistoryResponse, error) {
+	util.DPrintf("History %s", in.Key)
+	historyResponse := new(kvrpc.HistoryResponse)
+	kvs.applyMu.RLock()
+	versions, trimmed := kvs.store.History(in.Key)
+	kvs.applyMu.RUnlock()
+	historyResponse.Trimmed = trimmed
+	for _, v := range versions {
+		kv := &kvrpc.KeyVersion{
+			Value:   string(v.Value),
+			Deleted: v.Deleted,
+			Version: v.VectorClock,
+			Hlc:     v.Timestamp,
+		}
+		if v.Timestamp != 0 {
+			kv.Timestamp = hlc.Time(v.Timestamp).UnixMilli()
+		}
+		historyResponse.Versions = append(historyResponse.Versions, kv)
+	}
+	return historyResponse, nil
+}
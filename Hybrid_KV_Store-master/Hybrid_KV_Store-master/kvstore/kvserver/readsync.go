@@ -0,0 +1,114 @@
+package main
+
+/*
+	集群范围的读检测
+	writeless Get 只会同步本节点缓存的写，其它节点上延迟的写对该Get不可见
+	默认只做不需要通信的检测: gossip得到的某个节点自己的vectorclock计数大于本节点已应用的计数时，该节点还有延迟或者在途的写，
+	它在返回中标记为lagging，结果可能是陈旧的
+	-pullDeferred 时 Get 在返回之前向其它节点拉取该key的延迟写，未能及时响应的节点标记为lagging
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/lattices"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// FlushDeferredInCausal returns the deferred puts of the key and syncs them to all peers
+func (kvs *KVServer) FlushDeferredInCausal(ctx context.Context, in *causalrpc.FlushDeferredInCausalRequest) (*causalrpc.FlushDeferredInCausalResponse, error) {
+	util.DPrintf("FlushDeferredInCausal %s", in.Key)
+	flushDeferredInCausalResponse := &causalrpc.FlushDeferredInCausalResponse{Success: true}
+	if util.LoadInt(kvs.putCountsInProxy, in.Key) == 0 {
+		return flushDeferredInCausalResponse, nil
+	}
+	util.DPrintf("Sync History Puts by remote Get")
+	flushDeferredInCausalResponse.MapLattice = kvs.syncHistoryPuts(in.Key)
+	return flushDeferredInCausalResponse, nil
+}
+
+// laggingPeers returns the peers which are known to have accepted writes not applied on this node: the counter of
+// a peer in its own vectorclock, as received by gossip or with its lattices, is ahead of the one of this node.
+// These are deferred puts or writes on their way, of any key; the check costs no round trip
+func (kvs *KVServer) laggingPeers() []string {
+	laggingNodes := make([]string, 0)
+	for _, nc := range kvs.stable.Clocks() {
+		if nc.Node == kvs.internalAddress {
+			continue
+		}
+		var applied int32
+		if val, ok := kvs.vectorclock.Load(nc.Node); ok {
+			applied = val.(int32)
+		}
+		if nc.VectorClock[nc.Node] > applied {
+			laggingNodes = append(laggingNodes, nc.Node)
+		}
+	}
+	return laggingNodes
+}
+
+// pullDeferred applies the deferred puts of key on all peers, returns the peers which did not answer.
+// Without -pullDeferred nothing is pulled, the peers known to lag are returned
+func (kvs *KVServer) pullDeferred(key string) []string {
+	if !kvs.pullDeferredOn {
+		return kvs.laggingPeers()
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	laggingNodes := make([]string, 0)
+	args := &causalrpc.FlushDeferredInCausalRequest{Key: key}
+	for i := 0; i < len(kvs.peers); i++ {
+		if kvs.peers[i] == kvs.internalAddress {
+			continue
+		}
+		wg.Add(1)
+		go func(peer string) {
+			defer wg.Done()
+			reply, ok := kvs.sendFlushDeferredInCausal(peer, args)
+			if !ok || !reply.Success {
+				mu.Lock()
+				laggingNodes = append(laggingNodes, peer)
+				mu.Unlock()
+				return
+			}
+			if len(reply.MapLattice) == 0 {
+				return
+			}
+			var mlFromOther lattices.HybridLattice
+			json.Unmarshal(reply.MapLattice, &mlFromOther)
+			kvs.applyLatticeInCausal(mlFromOther)
+		}(kvs.peers[i])
+	}
+	wg.Wait()
+	if len(laggingNodes) > 0 {
+		util.DPrintf("pullDeferred %s: lagging nodes %v", key, laggingNodes)
+	}
+	return laggingNodes
+}
+
+func (kvs *KVServer) sendFlushDeferredInCausal(address string, args *causalrpc.FlushDeferredInCausalRequest) (*causalrpc.FlushDeferredInCausalResponse, bool) {
+	// 随机等待，模拟延迟
+	time.Sleep(time.Millisecond * time.Duration(kvs.latency+rand.Intn(25)))
+	ctx, cancel := context.WithTimeout(context.Background(), kvs.pullTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		util.EPrintf("sendFlushDeferredInCausal did not connect: %v", err)
+		return nil, false
+	}
+	defer conn.Close()
+	client := causalrpc.NewCAUSALClient(conn)
+	reply, err := client.FlushDeferredInCausal(ctx, args)
+	if err != nil {
+		util.EPrintf("sendFlushDeferredInCausal could not greet: %v %v", err, address)
+		return nil, false
+	}
+	return reply, true
+}
@@ -0,0 +1,73 @@
+package main
+
+/*
+	只读事务 (COPS-GT / Eiger)
+	第一次读取时固定快照: 节点当前的vectorclock，它只覆盖已经应用的写 (递增vectorclock和应用写在applyMu下原子执行)
+	之后的读取带着快照，可以发给任意已经追上快照的节点，从每个key的多版本历史中读取快照可见的最新版本
+	历史被截断时返回snapshot_expired，客户端重新开始事务
+*/
+
+import (
+	"context"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/store"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readAtSnapshot reads keys at snapshot, an empty snapshot pins the vectorclock of this node.
+// ok is false if this node has not caught up with vc or the snapshot, expired is true if a key has no version at the snapshot any more
+func (kvs *KVServer) readAtSnapshot(vc map[string]int32, snapshot map[string]int32, keys []string) (values [][]byte, pinned map[string]int32, ok bool, expired bool) {
+	kvs.applyMu.RLock()
+	defer kvs.applyMu.RUnlock()
+	if len(snapshot) == 0 {
+		if !util.IsUpper(kvs.vectorclock, util.BecomeSyncMap(vc)) {
+			return nil, nil, false, false
+		}
+		snapshot = util.BecomeMap(kvs.vectorclock)
+	} else {
+		snapshot = kvs.completeClock(snapshot)
+		if !util.IsUpper(kvs.vectorclock, util.BecomeSyncMap(snapshot)) {
+			return nil, snapshot, false, false
+		}
+	}
+	values = make([][]byte, len(keys))
+	for i, key := range keys {
+		value, found, err := kvs.store.VersionAt(key, snapshot)
+		if err == store.ErrSnapshotTooOld {
+			return nil, snapshot, true, true
+		}
+		if found {
+			values[i] = append([]byte{}, value...)
+		}
+	}
+	return values, snapshot, true, false
+}
+
+func (kvs *KVServer) ReadTxnInCausal(ctx context.Context, in *kvrpc.ReadTxnInCausalRequest) (*kvrpc.ReadTxnInCausalResponse, error) {
+	util.DPrintf("ReadTxnInCausal %v at %v", in.Keys, in.Snapshot)
+	readTxnInCausalResponse := new(kvrpc.ReadTxnInCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	vc = kvs.completeClock(vc)
+	values, snapshot, ok, expired := kvs.readAtSnapshot(vc, in.Snapshot, in.Keys)
+	readTxnInCausalResponse.Success = ok
+	readTxnInCausalResponse.SnapshotExpired = expired
+	readTxnInCausalResponse.Snapshot = snapshot
+	if ok && !expired {
+		readTxnInCausalResponse.Pairs = keyValues(in.Keys, values)
+	}
+	// the client has seen the snapshot
+	for id, counter := range snapshot {
+		if counter > vc[id] {
+			vc[id] = counter
+		}
+	}
+	readTxnInCausalResponse.Vectorclock = vc
+	readTxnInCausalResponse.Session = kvs.sessions.Encode(vc)
+	return readTxnInCausalResponse, nil
+}
@@ -0,0 +1,481 @@
+package main
+
+/*
+	Redis协议(RESP2/RESP3)的服务端，redis-cli、go-redis可以直接访问集群
+	每个连接是一个会话：一致性级别通过 HYDIS.CONSISTENCY 选择，vectorclock保存在服务端
+	支持的命令: GET SET DEL MGET MSET EXPIRE PING ECHO INFO HELLO SELECT CLIENT COMMAND CLUSTER QUIT HYDIS.CONSISTENCY
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/resprpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+const (
+	// redis version reported by HELLO and INFO, for clients which check it
+	respRedisVersion = "7.0.0"
+	// the whole key space of redis cluster, served by every node
+	respClusterSlots = 16384
+)
+
+// state of one RESP connection
+type respSession struct {
+	id          int64
+	consistency string
+	vectorclock map[string]int32
+	name        string
+}
+
+// 初始化RESP Server
+func (kvs *KVServer) RegisterRESPServer(address string) {
+	util.DPrintf("RegisterRESPServer: %s", address)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		util.EPrintf("Error RESP listening: %v", err)
+		return
+	}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			util.EPrintf("Error RESP accepting: %v", err)
+			return
+		}
+		go kvs.serveRESP(conn)
+	}
+}
+
+func (kvs *KVServer) serveRESP(conn net.Conn) {
+	defer conn.Close()
+	atomic.AddInt32(&kvs.respClients, 1)
+	defer atomic.AddInt32(&kvs.respClients, -1)
+	session := &respSession{
+		id:          atomic.AddInt64(&kvs.respNextID, 1),
+		consistency: kvs.respConsistency,
+		vectorclock: kvs.completeClock(nil),
+	}
+	reader := resprpc.NewReader(conn)
+	writer := resprpc.NewWriter(conn)
+	for {
+		args, err := reader.ReadCommand()
+		if err != nil {
+			if err == resprpc.ErrProtocol {
+				writer.WriteError("ERR Protocol error")
+				writer.Flush()
+			}
+			return
+		}
+		quit := kvs.execRESP(conn, session, writer, args)
+		// pipelined commands are answered with one write
+		if quit || reader.Buffered() == 0 {
+			if err := writer.Flush(); err != nil {
+				return
+			}
+		}
+		if quit {
+			return
+		}
+	}
+}
+
+// respGet reads key under the consistency of the session, value is nil if the key does not exist
+func (kvs *KVServer) respGet(session *respSession, key string) (value []byte, ok bool) {
+	value, _, vc, ok := kvs.readKey(session.consistency, session.vectorclock, key)
+	if ok {
+		session.vectorclock = vc
+	}
+	return value, ok
+}
+
+// respWrite executes a Put, Delete or Expire under the consistency of the session
+func (kvs *KVServer) respWrite(session *respSession, op config.Log) bool {
+	vc, ok := kvs.writeLog(session.consistency, session.vectorclock, op)
+	if ok {
+		session.vectorclock = vc
+	}
+	return ok
+}
+
+func respWrongArgs(w *resprpc.Writer, name string) {
+	w.WriteError(fmt.Sprintf("ERR wrong number of arguments for '%s' command", strings.ToLower(name)))
+}
+
+// the session vectorclock is ahead of this node, the client may retry or reconnect to another node
+func respTryAgain(w *resprpc.Writer) {
+	w.WriteError("TRYAGAIN this node has not caught up with the session vector clock")
+}
+
+// execRESP writes the reply of one command, returns true if the connection should be closed
+func (kvs *KVServer) execRESP(conn net.Conn, session *respSession, w *resprpc.Writer, args []string) bool {
+	if len(args) == 0 {
+		w.WriteError("ERR empty command")
+		return false
+	}
+	name := strings.ToUpper(args[0])
+	util.DPrintf("execRESP: %v", name)
+	switch name {
+	case "PING":
+		if len(args) > 2 {
+			respWrongArgs(w, name)
+		} else if len(args) == 2 {
+			w.WriteBulkString(args[1])
+		} else {
+			w.WriteSimple("PONG")
+		}
+	case "ECHO":
+		if len(args) != 2 {
+			respWrongArgs(w, name)
+			break
+		}
+		w.WriteBulkString(args[1])
+	case "QUIT":
+		w.WriteSimple("OK")
+		return true
+	case "HELLO":
+		kvs.respHello(session, w, args)
+	case "SELECT":
+		if len(args) != 2 {
+			respWrongArgs(w, name)
+		} else if args[1] != "0" {
+			w.WriteError("ERR DB index is out of range")
+		} else {
+			w.WriteSimple("OK")
+		}
+	case "CLIENT":
+		kvs.respClient(session, w, args)
+	case "COMMAND":
+		// no command docs, redis-cli works without them
+		w.WriteArray(0)
+	case "CLUSTER":
+		kvs.respCluster(conn, w, args)
+	case "INFO":
+		w.WriteBulkString(kvs.respInfo(session, args[1:]))
+	case "HYDIS.CONSISTENCY":
+		if len(args) > 2 {
+			respWrongArgs(w, name)
+		} else if len(args) == 1 {
+			w.WriteBulkString(session.consistency)
+		} else if level := parseConsistency(args[1]); level == "" {
+			w.WriteError(fmt.Sprintf("ERR unknown consistency level '%s'", args[1]))
+		} else {
+			session.consistency = level
+			w.WriteSimple("OK")
+		}
+	case "GET":
+		if len(args) != 2 {
+			respWrongArgs(w, name)
+			break
+		}
+		value, ok := kvs.respGet(session, args[1])
+		if !ok {
+			respTryAgain(w)
+		} else if value == nil {
+			w.WriteNull()
+		} else {
+			w.WriteBulk(value)
+		}
+	case "MGET":
+		if len(args) < 2 {
+			respWrongArgs(w, name)
+			break
+		}
+		values := make([][]byte, 0, len(args)-1)
+		for _, key := range args[1:] {
+			value, ok := kvs.respGet(session, key)
+			if !ok {
+				break
+			}
+			values = append(values, value)
+		}
+		if len(values) < len(args)-1 {
+			respTryAgain(w)
+			break
+		}
+		w.WriteArray(len(values))
+		for _, value := range values {
+			if value == nil {
+				w.WriteNull()
+			} else {
+				w.WriteBulk(value)
+			}
+		}
+	case "SET":
+		kvs.respSet(session, w, args)
+	case "MSET":
+		if len(args) < 3 || len(args)%2 == 0 {
+			respWrongArgs(w, name)
+			break
+		}
+		ok := true
+		for i := 1; i < len(args) && ok; i += 2 {
+			ok = kvs.respWrite(session, config.Log{Option: "Put", Key: args[i], Value: args[i+1]})
+		}
+		if ok {
+			w.WriteSimple("OK")
+		} else {
+			respTryAgain(w)
+		}
+	case "DEL":
+		if len(args) < 2 {
+			respWrongArgs(w, name)
+			break
+		}
+		deleted := int64(0)
+		ok := true
+		for _, key := range args[1:] {
+			if kvs.store.Has(key) {
+				deleted++
+			}
+			if ok = kvs.respWrite(session, config.Log{Option: "Delete", Key: key}); !ok {
+				break
+			}
+		}
+		if ok {
+			w.WriteInt(deleted)
+		} else {
+			respTryAgain(w)
+		}
+	case "EXPIRE":
+		if len(args) != 3 {
+			respWrongArgs(w, name)
+			break
+		}
+		seconds, err := strconv.Atoi(args[2])
+		if err != nil {
+			w.WriteError("ERR value is not an integer or out of range")
+			break
+		}
+		if !kvs.store.Has(args[1]) {
+			w.WriteInt(0)
+			break
+		}
+		// a non-positive timeout deletes the key, like redis
+		op := config.Log{Option: "Expire", Key: args[1], Value: strconv.Itoa(seconds)}
+		if seconds <= 0 {
+			op = config.Log{Option: "Delete", Key: args[1]}
+		}
+		if kvs.respWrite(session, op) {
+			w.WriteInt(1)
+		} else {
+			respTryAgain(w)
+		}
+	default:
+		w.WriteError(fmt.Sprintf("ERR unknown command '%s'", args[0]))
+	}
+	return false
+}
+
+// SET key value [NX | XX] [EX seconds | PX milliseconds]
+// NX and XX are checked against the local replica only
+func (kvs *KVServer) respSet(session *respSession, w *resprpc.Writer, args []string) {
+	if len(args) < 3 {
+		respWrongArgs(w, args[0])
+		return
+	}
+	key, value := args[1], args[2]
+	seconds := 0
+	nx, xx := false, false
+	for i := 3; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "NX":
+			nx = true
+		case "XX":
+			xx = true
+		case "EX", "PX":
+			if i+1 >= len(args) || seconds != 0 {
+				w.WriteError("ERR syntax error")
+				return
+			}
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				w.WriteError("ERR invalid expire time in 'set' command")
+				return
+			}
+			if strings.ToUpper(args[i]) == "PX" {
+				// the store expires in seconds, round up
+				n = (n + 999) / 1000
+			}
+			seconds = n
+			i++
+		default:
+			w.WriteError("ERR syntax error")
+			return
+		}
+	}
+	if nx && xx {
+		w.WriteError("ERR syntax error")
+		return
+	}
+	if (nx && kvs.store.Has(key)) || (xx && !kvs.store.Has(key)) {
+		w.WriteNull()
+		return
+	}
+	if kvs.respWrite(session, config.Log{Option: "Put", Key: key, Value: value, TTL: seconds}) {
+		w.WriteSimple("OK")
+	} else {
+		respTryAgain(w)
+	}
+}
+
+// HELLO [protover [AUTH username password] [SETNAME clientname]]
+func (kvs *KVServer) respHello(session *respSession, w *resprpc.Writer, args []string) {
+	if len(args) > 1 {
+		proto, err := strconv.Atoi(args[1])
+		if err != nil {
+			w.WriteError("ERR Protocol version is not an integer or out of range")
+			return
+		}
+		if proto != 2 && proto != 3 {
+			w.WriteError("NOPROTO unsupported protocol version")
+			return
+		}
+		for i := 2; i < len(args); i++ {
+			switch strings.ToUpper(args[i]) {
+			case "AUTH":
+				// no authentication, any credentials are accepted
+				i += 2
+			case "SETNAME":
+				if i+1 < len(args) {
+					session.name = args[i+1]
+				}
+				i++
+			default:
+				w.WriteError("ERR syntax error in HELLO option '" + args[i] + "'")
+				return
+			}
+		}
+		w.Proto = proto
+	}
+	w.WriteMap(7)
+	w.WriteBulkString("server")
+	w.WriteBulkString("redis")
+	w.WriteBulkString("version")
+	w.WriteBulkString(respRedisVersion)
+	w.WriteBulkString("proto")
+	w.WriteInt(int64(w.Proto))
+	w.WriteBulkString("id")
+	w.WriteInt(session.id)
+	w.WriteBulkString("mode")
+	w.WriteBulkString("standalone")
+	w.WriteBulkString("role")
+	w.WriteBulkString("master")
+	w.WriteBulkString("modules")
+	w.WriteArray(0)
+}
+
+// CLIENT ID | GETNAME | SETNAME name | SETINFO attr value
+func (kvs *KVServer) respClient(session *respSession, w *resprpc.Writer, args []string) {
+	if len(args) < 2 {
+		respWrongArgs(w, args[0])
+		return
+	}
+	switch strings.ToUpper(args[1]) {
+	case "ID":
+		w.WriteInt(session.id)
+	case "GETNAME":
+		if session.name == "" {
+			w.WriteNull()
+		} else {
+			w.WriteBulkString(session.name)
+		}
+	case "SETNAME":
+		if len(args) != 3 {
+			respWrongArgs(w, "client|setname")
+			return
+		}
+		session.name = args[2]
+		w.WriteSimple("OK")
+	case "SETINFO":
+		w.WriteSimple("OK")
+	default:
+		w.WriteError(fmt.Sprintf("ERR unknown subcommand '%s'", args[1]))
+	}
+}
+
+// CLUSTER SLOTS: every node is a full replica, so it reports itself as the owner of all slots,
+// redis cluster clients (benchmark/redis_cluster) then send every command to the node they asked
+func (kvs *KVServer) respCluster(conn net.Conn, w *resprpc.Writer, args []string) {
+	if len(args) < 2 || strings.ToUpper(args[1]) != "SLOTS" {
+		w.WriteError("ERR only CLUSTER SLOTS is supported")
+		return
+	}
+	host, portStr, _ := net.SplitHostPort(conn.LocalAddr().String())
+	port, _ := strconv.Atoi(portStr)
+	w.WriteArray(1)
+	w.WriteArray(3)
+	w.WriteInt(0)
+	w.WriteInt(respClusterSlots - 1)
+	w.WriteArray(2)
+	w.WriteBulkString(host)
+	w.WriteInt(int64(port))
+}
+
+// respInfo returns the sections of INFO, all sections if none is given
+func (kvs *KVServer) respInfo(session *respSession, sections []string) string {
+	wanted := make(map[string]bool)
+	for _, section := range sections {
+		wanted[strings.ToLower(section)] = true
+	}
+	all := len(wanted) == 0 || wanted["all"] || wanted["everything"] || wanted["default"]
+	var b strings.Builder
+	if all || wanted["server"] {
+		b.WriteString("# Server\r\n")
+		fmt.Fprintf(&b, "redis_version:%s\r\n", respRedisVersion)
+		b.WriteString("redis_mode:standalone\r\n")
+		fmt.Fprintf(&b, "address:%s\r\n", kvs.address)
+		fmt.Fprintf(&b, "internal_address:%s\r\n\r\n", kvs.internalAddress)
+	}
+	if all || wanted["clients"] {
+		b.WriteString("# Clients\r\n")
+		fmt.Fprintf(&b, "connected_clients:%d\r\n\r\n", atomic.LoadInt32(&kvs.respClients))
+	}
+	if all || wanted["replication"] {
+		b.WriteString("# Replication\r\n")
+		b.WriteString("role:master\r\n")
+		fmt.Fprintf(&b, "peers:%s\r\n", strings.Join(kvs.peers, ","))
+		vc, _ := json.Marshal(util.BecomeMap(kvs.vectorclock))
+		fmt.Fprintf(&b, "vector_clock:%s\r\n\r\n", vc)
+	}
+	if all || wanted["hydis"] {
+		kvs.deferredMu.Lock()
+		deferredKeys, deferredBytes := len(kvs.deferred), kvs.deferredBytes
+		kvs.deferredMu.Unlock()
+		b.WriteString("# Hydis\r\n")
+		fmt.Fprintf(&b, "consistency:%s\r\n", session.consistency)
+		vc, _ := json.Marshal(session.vectorclock)
+		fmt.Fprintf(&b, "session_vector_clock:%s\r\n", vc)
+		fmt.Fprintf(&b, "deferred_keys:%d\r\n", deferredKeys)
+		fmt.Fprintf(&b, "deferred_bytes:%d\r\n\r\n", deferredBytes)
+	}
+	if all || wanted["stats"] {
+		cache := kvs.store.CacheStats()
+		b.WriteString("# Stats\r\n")
+		fmt.Fprintf(&b, "keyspace_hits:%d\r\n", cache.Hits)
+		fmt.Fprintf(&b, "keyspace_misses:%d\r\n", cache.Misses)
+		fmt.Fprintf(&b, "evicted_keys:%d\r\n", cache.Evictions)
+		fmt.Fprintf(&b, "expired_keys:%d\r\n", cache.Expired)
+		fmt.Fprintf(&b, "store_engine:%s\r\n", cache.Engine)
+		fmt.Fprintf(&b, "cache_bytes:%d\r\n", cache.CacheBytes)
+		fmt.Fprintf(&b, "cache_keys:%d\r\n", cache.Entries)
+		fmt.Fprintf(&b, "cache_admission:%s\r\n", cache.Admission)
+		fmt.Fprintf(&b, "cache_admitted:%d\r\n", cache.Admitted)
+		fmt.Fprintf(&b, "cache_rejected:%d\r\n", cache.Rejected)
+		fmt.Fprintf(&b, "cache_engine_hits:%d\r\n", cache.EngineHits)
+		fmt.Fprintf(&b, "cache_hit_ratio:%.4f\r\n", cache.HitRatio())
+		historyBytes, historyKeys := kvs.store.HistoryBytes()
+		fmt.Fprintf(&b, "history_bytes:%d\r\n", historyBytes)
+		fmt.Fprintf(&b, "history_keys:%d\r\n\r\n", historyKeys)
+	}
+	if all || wanted["keyspace"] {
+		b.WriteString("# Keyspace\r\n")
+		fmt.Fprintf(&b, "db0:keys=%d\r\n", kvs.store.Len())
+	}
+	return b.String()
+}
@@ -0,0 +1,123 @@
+package main
+
+/*
+	范围扫描和前缀扫描
+	按key的顺序返回一页key和值以及最后一次写的vectorclock，next_page_token是下一页的第一个key(base64)
+	每一页在applyMu的读锁下读取，属于同一个因果快照；不同的页之间可能有新的写
+*/
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultScanLimit = 100
+	maxScanLimit     = 10000
+)
+
+var errPageToken = errors.New("invalid page token")
+
+// scanRequest is the common part of the scan requests
+type scanRequest struct {
+	start     string
+	end       string
+	prefix    string
+	limit     int32
+	pageToken string
+}
+
+// scanPage reads one page of keys, the page token is the first key of the page
+func (kvs *KVServer) scanPage(req scanRequest) (entries []*kvrpc.ScanEntry, nextPageToken string, err error) {
+	start := req.start
+	if req.pageToken != "" {
+		key, err := base64.RawURLEncoding.DecodeString(req.pageToken)
+		if err != nil {
+			return nil, "", errPageToken
+		}
+		start = string(key)
+	}
+	limit := int(req.limit)
+	if limit <= 0 {
+		limit = defaultScanLimit
+	}
+	if limit > maxScanLimit {
+		limit = maxScanLimit
+	}
+	kvs.applyMu.RLock()
+	defer kvs.applyMu.RUnlock()
+	var keys []string
+	var next string
+	if req.prefix != "" {
+		keys, next = kvs.store.ScanPrefix(req.prefix, start, limit)
+	} else {
+		keys, next = kvs.store.Scan(start, req.end, limit)
+	}
+	entries = make([]*kvrpc.ScanEntry, 0, len(keys))
+	for _, key := range keys {
+		entry := &kvrpc.ScanEntry{
+			Key:   key,
+			Value: string(kvs.store.Get(key)),
+		}
+		if version, ok := kvs.store.LatestVersion(key); ok {
+			entry.Version = version.VectorClock
+			entry.Hlc = version.Timestamp
+		}
+		entries = append(entries, entry)
+	}
+	if next != "" {
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(next))
+	}
+	return entries, nextPageToken, nil
+}
+
+func (kvs *KVServer) ScanInCausal(ctx context.Context, in *kvrpc.ScanInCausalRequest) (*kvrpc.ScanInCausalResponse, error) {
+	util.DPrintf("ScanInCausal [%s, %s) prefix %s", in.Start, in.End, in.Prefix)
+	scanInCausalResponse := new(kvrpc.ScanInCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	vc = kvs.completeClock(vc)
+	op := config.Log{
+		Option: "Get",
+	}
+	if !kvs.startInCausal(op, vc, time.Now().UnixMicro()) {
+		scanInCausalResponse.Vectorclock = vc
+		scanInCausalResponse.Session = kvs.sessions.Encode(vc)
+		return scanInCausalResponse, nil
+	}
+	entries, next, err := kvs.scanPage(scanRequest{in.Start, in.End, in.Prefix, in.Limit, in.PageToken})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scanInCausalResponse.Entries = entries
+	scanInCausalResponse.NextPageToken = next
+	scanInCausalResponse.Success = true
+	scanInCausalResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+	scanInCausalResponse.Session = kvs.sessionToken()
+	return scanInCausalResponse, nil
+}
+
+func (kvs *KVServer) ScanInEventual(ctx context.Context, in *kvrpc.ScanInEventualRequest) (*kvrpc.ScanInEventualResponse, error) {
+	util.DPrintf("ScanInEventual [%s, %s) prefix %s", in.Start, in.End, in.Prefix)
+	scanInEventualResponse := new(kvrpc.ScanInEventualResponse)
+	entries, next, err := kvs.scanPage(scanRequest{in.Start, in.End, in.Prefix, in.Limit, in.PageToken})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scanInEventualResponse.Entries = entries
+	scanInEventualResponse.NextPageToken = next
+	scanInEventualResponse.Success = true
+	scanInEventualResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+	scanInEventualResponse.Session = kvs.sessionToken()
+	return scanInEventualResponse, nil
+}
@@ -0,0 +1,240 @@
+package main
+
+/*
+	快照: Snapshot在applyMu的读锁下记录vectorclock、writeless统计并固定store的一个视图(store.View)，之后不持锁逐个读取key按snapshot包的格式流式发送
+	Restore把快照载入一个空的节点(没有key，vectorclock全为0)，收到的key先写入store的staging，校验完footer之后才替换节点的key
+	memory引擎载入的key同时写入WAL，重启之后不会丢失
+	启动参数 -restore 载入快照文件，-bootstrapFrom 从另一个节点拉取快照，都在开始服务之前完成
+	启动时先完整读取并校验快照，之后 -restore 才把节点原有的WAL和key移到一边；任何一步失败节点都直接退出
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/adminrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/snapshot"
+	"github.com/JasonLou99/Hybrid_KV_Store/store"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var errNotEmpty = errors.New("the node is not empty, a snapshot is only restored into an empty node")
+
+// writeSnapshot streams the state of the node. All writes hold applyMu, the header and the view of the keys
+// are taken under its read lock, the keys are then read from the view without blocking the writes
+func (kvs *KVServer) writeSnapshot(w io.Writer) (entries int, err error) {
+	kvs.applyMu.RLock()
+	header := snapshot.Header{
+		Node:        kvs.internalAddress,
+		VectorClock: util.BecomeMap(kvs.vectorclock),
+		HLC:         kvs.clock.Now(),
+		Created:     time.Now().UnixMilli(),
+		Stats:       kvs.stats.Snapshot(),
+	}
+	view, err := kvs.store.NewView(header.VectorClock)
+	kvs.applyMu.RUnlock()
+	if err != nil {
+		return 0, err
+	}
+	defer view.Release()
+	sw, err := snapshot.NewWriter(w, header)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		entry, ok, err := view.Next()
+		if err != nil {
+			return entries, err
+		}
+		if !ok {
+			break
+		}
+		err = sw.WriteEntry(snapshot.Entry{
+			Key:         entry.Key,
+			Value:       string(entry.Value),
+			TTL:         entry.TTL,
+			VectorClock: entry.Version.VectorClock,
+			HLC:         entry.Version.Timestamp,
+		})
+		if err != nil {
+			return entries, err
+		}
+		entries++
+	}
+	return entries, sw.Close()
+}
+
+// emptyLocked reports whether the node has no keys and has applied no write, requires applyMu
+func (kvs *KVServer) emptyLocked() bool {
+	if kvs.store.Len() > 0 {
+		return false
+	}
+	for _, counter := range util.BecomeMap(kvs.vectorclock) {
+		if counter != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (kvs *KVServer) empty() bool {
+	kvs.applyMu.RLock()
+	defer kvs.applyMu.RUnlock()
+	return kvs.emptyLocked()
+}
+
+// stagedSnapshot is a complete snapshot in the staging area of the store, its footer is verified
+type stagedSnapshot struct {
+	header  snapshot.Header
+	staging *store.Staging
+}
+
+// stageSnapshot writes the entries of a snapshot to a staging area of the store as they arrive,
+// nothing is staged if the snapshot is invalid
+func (kvs *KVServer) stageSnapshot(r io.Reader) (*stagedSnapshot, error) {
+	sr, err := snapshot.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	staging, err := kvs.store.NewStaging()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		entry, err := sr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			staging.Abort()
+			return nil, err
+		}
+		if err := staging.Put(entry.Key, entry.Value, entry.TTL, entry.VectorClock, entry.HLC); err != nil {
+			staging.Abort()
+			return nil, err
+		}
+	}
+	return &stagedSnapshot{header: sr.Header(), staging: staging}, nil
+}
+
+// restoreSnapshot loads a complete snapshot into the empty node, the keys of the node are only replaced once the footer is verified
+func (kvs *KVServer) restoreSnapshot(r io.Reader) (keys int64, err error) {
+	if !kvs.empty() {
+		return 0, errNotEmpty
+	}
+	staged, err := kvs.stageSnapshot(r)
+	if err != nil {
+		return 0, err
+	}
+	return kvs.loadSnapshot(staged)
+}
+
+// loadSnapshot replaces the keys of the empty node by the staged snapshot
+func (kvs *KVServer) loadSnapshot(staged *stagedSnapshot) (keys int64, err error) {
+	header := staged.header
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	if !kvs.emptyLocked() {
+		staged.staging.Abort()
+		return 0, errNotEmpty
+	}
+	durable := kvs.store.Durable()
+	loaded, err := staged.staging.Commit(func(key string, value string, ttl uint32, version store.Version) {
+		if durable {
+			return
+		}
+		// the memory engine is rebuilt from the write-ahead log after a restart
+		logs := []config.Log{{Option: "Put", Key: key, Value: value, TTL: int(ttl)}}
+		vc := version.VectorClock
+		if vc == nil {
+			vc = header.VectorClock
+		}
+		kvs.writeAhead(logs, vc, version.Timestamp, header.Node)
+	})
+	if err != nil {
+		return 0, err
+	}
+	// the vectorclock of the snapshot also covers deleted keys, a record without writes keeps it in the log
+	kvs.writeAhead(nil, header.VectorClock, header.HLC, header.Node)
+	kvs.MergeVC(util.BecomeSyncMap(header.VectorClock))
+	kvs.clock.Update(header.HLC)
+	if header.Stats != nil {
+		kvs.stats.Restore(header.Stats)
+		kvs.saveStats()
+	}
+	util.IPrintf("Restored %d keys from the snapshot of %s, vectorclock %v", loaded, header.Node, header.VectorClock)
+	return int64(loaded), nil
+}
+
+// moveAside renames the write-ahead log in dir before a snapshot is restored at startup
+func moveAside(dir string) error {
+	if _, err := os.Stat(dir); err != nil {
+		return nil
+	}
+	aside := fmt.Sprintf("%s.%d", dir, time.Now().Unix())
+	if err := os.Rename(dir, aside); err != nil {
+		return err
+	}
+	util.IPrintf("The write-ahead log is moved to %s before the restore", aside)
+	return nil
+}
+
+// stageFile stages a snapshot file
+func (kvs *KVServer) stageFile(path string) (*stagedSnapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return kvs.stageSnapshot(f)
+}
+
+// stageBootstrap stages the snapshot streamed by the node at address (its kvrpc address)
+func (kvs *KVServer) stageBootstrap(address string) (*stagedSnapshot, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := adminrpc.NewADMINClient(conn).Snapshot(ctx, &adminrpc.SnapshotRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return kvs.stageSnapshot(adminrpc.SnapshotReader(stream))
+}
+
+func (kvs *KVServer) Snapshot(in *adminrpc.SnapshotRequest, stream adminrpc.ADMIN_SnapshotServer) error {
+	util.DPrintf("Snapshot")
+	entries, err := kvs.writeSnapshot(adminrpc.SnapshotWriter(stream))
+	if err != nil {
+		return err
+	}
+	util.IPrintf("Snapshot of %d keys sent", entries)
+	return nil
+}
+
+func (kvs *KVServer) Restore(stream adminrpc.ADMIN_RestoreServer) error {
+	util.DPrintf("Restore")
+	restoreResponse := new(adminrpc.RestoreResponse)
+	keys, err := kvs.restoreSnapshot(adminrpc.RestoreReader(stream))
+	if err == errNotEmpty {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	restoreResponse.Keys = keys
+	restoreResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+	return stream.SendAndClose(restoreResponse)
+}
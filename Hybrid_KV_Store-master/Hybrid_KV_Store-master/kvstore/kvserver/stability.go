@@ -0,0 +1,92 @@
+package main
+
+/*
+	稳定前沿: 每个节点定期把自己的vectorclock gossip给其它节点，收到的lattice也带有发送者的vectorclock
+	stability.Tracker计算所有节点的vectorclock的最小值，前沿前进时通知订阅者(WAL的截断等)释放状态
+*/
+
+import (
+	"context"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/adminrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const defaultClockGossipInterval = time.Second
+
+// observePeerClock records the vectorclock of a lattice from a peer
+func (kvs *KVServer) observePeerClock(peer string, vc map[string]int32) {
+	if peer == "" || peer == kvs.internalAddress {
+		return
+	}
+	kvs.stable.Observe(peer, vc)
+}
+
+// clockGossipLoop sends the vectorclock of this node to the peers every clockGossipInterval
+func (kvs *KVServer) clockGossipLoop() {
+	if kvs.clockGossipInterval <= 0 {
+		return
+	}
+	for {
+		vc := util.BecomeMap(kvs.vectorclock)
+		kvs.stable.Observe(kvs.internalAddress, vc)
+		args := &causalrpc.GossipClockInCausalRequest{
+			From:        kvs.internalAddress,
+			Vectorclock: vc,
+		}
+		for i := 0; i < len(kvs.peers); i++ {
+			if kvs.peers[i] != kvs.internalAddress {
+				go kvs.sendGossipClockInCausal(kvs.peers[i], args)
+			}
+		}
+		time.Sleep(kvs.clockGossipInterval)
+	}
+}
+
+func (kvs *KVServer) GossipClockInCausal(ctx context.Context, in *causalrpc.GossipClockInCausalRequest) (*causalrpc.GossipClockInCausalResponse, error) {
+	gossipClockInCausalResponse := &causalrpc.GossipClockInCausalResponse{}
+	kvs.observePeerClock(in.From, in.Vectorclock)
+	gossipClockInCausalResponse.Success = true
+	return gossipClockInCausalResponse, nil
+}
+
+func (kvs *KVServer) sendGossipClockInCausal(address string, args *causalrpc.GossipClockInCausalRequest) (*causalrpc.GossipClockInCausalResponse, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), kvs.clockGossipInterval)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		util.EPrintf("sendGossipClockInCausal did not connect: %v", err)
+		return nil, false
+	}
+	defer conn.Close()
+	client := causalrpc.NewCAUSALClient(conn)
+	reply, err := client.GossipClockInCausal(ctx, args)
+	if err != nil {
+		util.EPrintf("sendGossipClockInCausal could not greet: %v %v", err, address)
+		return nil, false
+	}
+	return reply, true
+}
+
+func (kvs *KVServer) GetStableFrontier(ctx context.Context, in *adminrpc.GetStableFrontierRequest) (*adminrpc.GetStableFrontierResponse, error) {
+	util.DPrintf("GetStableFrontier")
+	getStableFrontierResponse := new(adminrpc.GetStableFrontierResponse)
+	// the clock of this node is fresh, the ones of the peers are as of their last gossip
+	kvs.stable.Observe(kvs.internalAddress, util.BecomeMap(kvs.vectorclock))
+	getStableFrontierResponse.Frontier, getStableFrontierResponse.Complete = kvs.stable.Frontier()
+	for _, clock := range kvs.stable.Clocks() {
+		nodeClock := &adminrpc.NodeClock{
+			Node:        clock.Node,
+			Vectorclock: clock.VectorClock,
+		}
+		if !clock.Updated.IsZero() {
+			nodeClock.Updated = clock.Updated.UnixMilli()
+		}
+		getStableFrontierResponse.Clocks = append(getStableFrontierResponse.Clocks, nodeClock)
+	}
+	return getStableFrontierResponse, nil
+}
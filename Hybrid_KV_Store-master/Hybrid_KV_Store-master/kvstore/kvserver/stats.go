@@ -0,0 +1,128 @@
+package main
+
+/*
+	writeless读写统计的持久化与gossip
+	本地统计定期写入store的metadata，重启后恢复；同时发送给其它节点，用于预测整个集群的读写模式
+	统计按statsChunkKeys个key分成多条消息发送，接收端收齐一轮的所有消息之后才替换该节点的统计
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"github.com/JasonLou99/Hybrid_KV_Store/writeless"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// key of the writeless statistics in the store metadata
+	statsMetaKey = "writeless/stats"
+	// keys per gossip message, about 1MB of JSON, far below the 4MB message limit of grpc
+	statsChunkKeys = 10000
+)
+
+func (kvs *KVServer) loadStats() {
+	data := kvs.store.GetMeta(statsMetaKey)
+	if data == nil {
+		return
+	}
+	var counts map[string]writeless.Counts
+	if err := json.Unmarshal(data, &counts); err != nil {
+		util.EPrintf("loadStats: %v", err)
+		return
+	}
+	kvs.stats.Restore(counts)
+	util.IPrintf("loadStats: %v keys", len(counts))
+}
+
+// saveStats persists the local statistics and returns them
+func (kvs *KVServer) saveStats() map[string]writeless.Counts {
+	counts := kvs.stats.Snapshot()
+	data, _ := json.Marshal(counts)
+	kvs.store.PutMeta(statsMetaKey, data)
+	return counts
+}
+
+// statsChunks splits the statistics into gossip messages of at most statsChunkKeys keys
+func (kvs *KVServer) statsChunks(counts map[string]writeless.Counts) []*causalrpc.GossipStatsInCausalRequest {
+	chunks := make([]map[string]writeless.Counts, 0, len(counts)/statsChunkKeys+1)
+	chunk := make(map[string]writeless.Counts)
+	for key, c := range counts {
+		if len(chunk) == statsChunkKeys {
+			chunks = append(chunks, chunk)
+			chunk = make(map[string]writeless.Counts)
+		}
+		chunk[key] = c
+	}
+	chunks = append(chunks, chunk)
+	round := time.Now().UnixNano()
+	args := make([]*causalrpc.GossipStatsInCausalRequest, 0, len(chunks))
+	for i, chunk := range chunks {
+		data, _ := json.Marshal(chunk)
+		args = append(args, &causalrpc.GossipStatsInCausalRequest{
+			From:   kvs.internalAddress,
+			Stats:  data,
+			Round:  round,
+			Chunk:  int32(i),
+			Chunks: int32(len(chunks)),
+		})
+	}
+	return args
+}
+
+// statsLoop trims, persists and gossips the local statistics every statsGossipInterval
+func (kvs *KVServer) statsLoop() {
+	if kvs.statsGossipInterval <= 0 {
+		return
+	}
+	for {
+		time.Sleep(kvs.statsGossipInterval)
+		kvs.stats.Trim()
+		chunks := kvs.statsChunks(kvs.saveStats())
+		for i := 0; i < len(kvs.peers); i++ {
+			if kvs.peers[i] != kvs.internalAddress {
+				go func(peer string) {
+					for _, args := range chunks {
+						if _, ok := kvs.sendGossipStatsInCausal(peer, args); !ok {
+							return
+						}
+					}
+				}(kvs.peers[i])
+			}
+		}
+	}
+}
+
+func (kvs *KVServer) GossipStatsInCausal(ctx context.Context, in *causalrpc.GossipStatsInCausalRequest) (*causalrpc.GossipStatsInCausalResponse, error) {
+	gossipStatsInCausalResponse := &causalrpc.GossipStatsInCausalResponse{}
+	var counts map[string]writeless.Counts
+	if err := json.Unmarshal(in.Stats, &counts); err != nil {
+		util.EPrintf("GossipStatsInCausal from %s: %v", in.From, err)
+		return gossipStatsInCausalResponse, nil
+	}
+	kvs.stats.MergePeerChunk(in.From, in.Round, int(in.Chunk), int(in.Chunks), counts)
+	gossipStatsInCausalResponse.Success = true
+	return gossipStatsInCausalResponse, nil
+}
+
+func (kvs *KVServer) sendGossipStatsInCausal(address string, args *causalrpc.GossipStatsInCausalRequest) (*causalrpc.GossipStatsInCausalResponse, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), kvs.statsGossipInterval)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		util.EPrintf("sendGossipStatsInCausal did not connect: %v", err)
+		return nil, false
+	}
+	defer conn.Close()
+	client := causalrpc.NewCAUSALClient(conn)
+	reply, err := client.GossipStatsInCausal(ctx, args)
+	if err != nil {
+		util.EPrintf("sendGossipStatsInCausal could not greet: %v %v", err, address)
+		return nil, false
+	}
+	return reply, true
+}
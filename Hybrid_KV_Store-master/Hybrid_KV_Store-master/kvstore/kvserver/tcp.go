@@ -0,0 +1,270 @@
+package main
+
+/*
+	原生TCP协议的服务端，帧格式见 rpc/tcprpc
+	连接的第一个字节为 tcprpc.Magic 时使用帧协议，否则按旧的裸JSON协议解析(HydisTcpClient)
+*/
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/tcprpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+// 初始化TCP Server
+func (kvs *KVServer) RegisterTCPServer(address string) {
+	util.DPrintf("RegisterTCPServer: %s", address)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		fmt.Println("Error Native TCP listening", err.Error())
+		return // 终止程序
+	}
+	// 监听并接受来自客户端的连接
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			fmt.Println("Error accepting", err.Error())
+			return // 终止程序
+		}
+		// 处理连接
+		go kvs.disributeRPC(conn)
+	}
+}
+
+func (kvs *KVServer) disributeRPC(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	first, err := reader.Peek(1)
+	if err != nil {
+		return
+	}
+	if first[0] == tcprpc.Magic {
+		kvs.serveFramedTCP(conn, reader)
+	} else {
+		kvs.serveLegacyTCP(conn, reader)
+	}
+}
+
+// requests are served in order, so pipelined responses keep the order of the requests
+func (kvs *KVServer) serveFramedTCP(conn net.Conn, reader *bufio.Reader) {
+	// 未握手的连接使用JSON
+	codec := tcprpc.Negotiate(tcprpc.EncodingJSON)
+	for {
+		f, err := tcprpc.ReadFrame(reader)
+		if err != nil {
+			util.DPrintf("serveFramedTCP: %v", err)
+			return //终止程序
+		}
+		if f.Kind == tcprpc.KindHello {
+			codec = tcprpc.Negotiate(string(f.Payload))
+			util.DPrintf("serveFramedTCP: %v negotiated encoding %s", conn.RemoteAddr(), codec.Name())
+			if err := tcprpc.WriteFrame(conn, &tcprpc.Frame{Kind: tcprpc.KindHello, RequestID: f.RequestID, Payload: []byte(codec.Name())}); err != nil {
+				return
+			}
+			continue
+		}
+		var message tcprpc.Request
+		tcpResp := &tcprpc.Response{}
+		if err := codec.UnmarshalRequest(f.Payload, &message); err != nil {
+			tcpResp.Error = err.Error()
+		} else {
+			tcpResp = kvs.handleTCPRequest(&message)
+		}
+		res, err := codec.MarshalResponse(tcpResp)
+		if err != nil {
+			util.EPrintf("serveFramedTCP: marshal response failed, err: %v", err)
+			return
+		}
+		if err := tcprpc.WriteFrame(conn, &tcprpc.Frame{Kind: tcprpc.KindMessage, RequestID: f.RequestID, Payload: res}); err != nil {
+			return
+		}
+	}
+}
+
+// one JSON message after another without framing, json.Decoder handles split and coalesced segments
+func (kvs *KVServer) serveLegacyTCP(conn net.Conn, reader *bufio.Reader) {
+	decoder := json.NewDecoder(reader)
+	for {
+		var message tcprpc.Request
+		if err := decoder.Decode(&message); err != nil {
+			// fmt.Println("Error reading", err.Error())
+			return //终止程序
+		}
+		tcpResp := kvs.handleTCPRequest(&message)
+		res, _ := json.Marshal(tcpResp)
+		if _, err := conn.Write(res); err != nil {
+			return
+		}
+	}
+}
+
+func (kvs *KVServer) handleTCPRequest(message *tcprpc.Request) *tcprpc.Response {
+	var tcpResp tcprpc.Response
+	vc, err := kvs.clientClock(message.Session, message.VectorClock)
+	if err != nil {
+		tcpResp.Error = err.Error()
+		return &tcpResp
+	}
+	consistencyLevel := message.Consistency
+	switch consistencyLevel {
+	case "GetInWritelessCausal":
+		key := message.Key
+		ts := time.Now().UnixMicro()
+		util.DPrintf("GetInWritelessCausal: %s", key)
+		op := config.Log{
+			Option: "Get",
+			Key:    key,
+			Value:  "",
+		}
+		ok := kvs.startInWritelessCausal(op, vc, ts)
+		if ok {
+			tcpResp.LaggingNodes = kvs.pullDeferred(key)
+			tcpResp.Stale = len(tcpResp.LaggingNodes) > 0
+			tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
+			tcpResp.Session = kvs.sessionToken()
+			tcpResp.Value = string(kvs.store.Get(key))
+			tcpResp.Success = true
+			tcpResp.Key = key
+		} else {
+			tcpResp.Value = ""
+			tcpResp.Success = false
+		}
+	case "PutInWritelessCausal":
+		key := message.Key
+		value := message.Value
+		ts := time.Now().UnixMicro()
+		util.DPrintf("PutInWritelessCausal: key:%s, val:%s, vc:%v, ts:%v", key, value, vc, ts)
+		// conn.Write([]byte("OK"))
+		op := config.Log{
+			Option: message.Operation,
+			Key:    key,
+			Value:  value,
+		}
+		// 更新计数， 比较预测值判断是否需要同步
+		proxyCounts := util.LoadInt(kvs.putCountsInProxy, key)
+		kvs.putCountsInProxy.Store(key, proxyCounts+1)
+		kvs.stats.AddPut(key)
+		// 以WritelessCausal一致性级别执行该请求
+		ok := kvs.startInWritelessCausal(op, vc, ts)
+		tcpResp.Operation = op.Option
+		tcpResp.Key = op.Key
+		tcpResp.Value = op.Value
+		if ok {
+			tcpResp.Success = true
+		} else {
+			util.DPrintf("PutInWritelessCausal: StartInWritelessCausal Failed key=%s value=%s, Because vcFromClient < kvs.vectorclock", key, value)
+			tcpResp.Success = false
+		}
+		tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
+		tcpResp.Session = kvs.sessionToken()
+	case "GetInCausal":
+		key := message.Key
+		ts := time.Now().UnixMicro()
+		util.DPrintf("GetInCausal: %s", key)
+		op := config.Log{
+			Option: "Get",
+			Key:    key,
+			Value:  "",
+		}
+		ok := kvs.startInCausal(op, vc, ts)
+		if ok {
+			tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
+			tcpResp.Session = kvs.sessionToken()
+			tcpResp.Value = string(kvs.store.Get(key))
+			tcpResp.Success = true
+			tcpResp.Key = key
+		} else {
+			tcpResp.Value = ""
+			tcpResp.Success = false
+		}
+	case "PutInCausal":
+		key := message.Key
+		value := message.Value
+		ts := time.Now().UnixMicro()
+		util.DPrintf("PutInCausal: key:%s, val:%s, vc:%v, ts:%v", key, value, vc, ts)
+		op := config.Log{
+			Option: message.Operation,
+			Key:    key,
+			Value:  value,
+		}
+		ok := kvs.startInCausal(op, vc, ts)
+		tcpResp.Operation = op.Option
+		tcpResp.Key = op.Key
+		tcpResp.Value = op.Value
+		if ok {
+			tcpResp.Success = true
+		} else {
+			util.DPrintf("PutInEventual: StartInEventual Failed key=%s value=%s, Because vcFromClient < kvs.vectorclock", key, value)
+			tcpResp.Success = false
+		}
+		tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
+		tcpResp.Session = kvs.sessionToken()
+	case "GetInEventual":
+		key := message.Key
+		ts := time.Now().UnixMicro()
+		util.DPrintf("GetInEventual: %s", key)
+		op := config.Log{
+			Option: "Get",
+			Key:    key,
+			Value:  "",
+		}
+		ok := kvs.startInEventual(op, vc, ts)
+		if ok {
+			tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
+			tcpResp.Session = kvs.sessionToken()
+			tcpResp.Value = string(kvs.store.Get(key))
+			tcpResp.Success = true
+			tcpResp.Key = key
+		} else {
+			tcpResp.Value = ""
+			tcpResp.Success = false
+		}
+	case "PutInEventual":
+		key := message.Key
+		value := message.Value
+		ts := time.Now().UnixMicro()
+		util.DPrintf("PutInEventual: key:%s, val:%s, vc:%v, ts:%v", key, value, vc, ts)
+		op := config.Log{
+			Option: message.Operation,
+			Key:    key,
+			Value:  value,
+		}
+		ok := kvs.startInEventual(op, vc, ts)
+		tcpResp.Operation = op.Option
+		tcpResp.Key = op.Key
+		tcpResp.Value = op.Value
+		if ok {
+			tcpResp.Success = true
+		} else {
+			util.DPrintf("PutInEventual: StartInEventual Failed key=%s value=%s, Because vcFromClient < kvs.vectorclock", key, value)
+			tcpResp.Success = false
+		}
+		tcpResp.VectorClock = util.BecomeMap(kvs.vectorclock)
+		tcpResp.Session = kvs.sessionToken()
+	case "MultiGetInCausal":
+		kvs.handleTCPMultiGet(ConsistencyCausal, message, vc, &tcpResp)
+	case "MultiPutInCausal":
+		kvs.handleTCPMultiPut(ConsistencyCausal, message, vc, &tcpResp)
+	case "MultiGetInWritelessCausal":
+		kvs.handleTCPMultiGet(ConsistencyWritelessCausal, message, vc, &tcpResp)
+	case "MultiPutInWritelessCausal":
+		kvs.handleTCPMultiPut(ConsistencyWritelessCausal, message, vc, &tcpResp)
+	case "MultiGetInEventual":
+		kvs.handleTCPMultiGet(ConsistencyEventual, message, vc, &tcpResp)
+	case "MultiPutInEventual":
+		kvs.handleTCPMultiPut(ConsistencyEventual, message, vc, &tcpResp)
+	case "CompareAndSetInCausal":
+		kvs.handleTCPCompareAndSet(ConsistencyCausal, message, vc, &tcpResp)
+	case "CompareAndSetInStrong":
+		kvs.handleTCPCompareAndSet(ConsistencyStrong, message, vc, &tcpResp)
+	default:
+		tcpResp.Error = "unknown consistency " + consistencyLevel
+	}
+	return &tcpResp
+}
@@ -0,0 +1,83 @@
+package main
+
+/*
+	预写日志: applyLogsLocked在写入store之前把每一组写追加到WAL，节点启动时把WAL重放到store并恢复vectorclock和HLC
+	稳定前沿(stability.go)前进时删除只包含稳定的写的段；延迟写(Writeless-Causal)同步给其它节点之前，它们的记录不会被删除
+	只有store的引擎是持久化的(tiered)才删除段，memory引擎重启之后只能从WAL恢复所有的写
+*/
+
+import (
+	"math"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/seglog"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"github.com/JasonLou99/Hybrid_KV_Store/wal"
+)
+
+// openWAL replays the log in dir into the store, then appends the writes applied from now on
+func (kvs *KVServer) openWAL(dir string, opts seglog.Options) error {
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	replayed := 0
+	log, err := wal.Open(dir, opts, func(r wal.Record) {
+		kvs.applyToStore(r.Logs, r.VectorClock, r.HLC)
+		kvs.MergeVC(util.BecomeSyncMap(r.VectorClock))
+		if r.HLC != 0 {
+			kvs.clock.Update(r.HLC)
+		}
+		replayed++
+	})
+	if err != nil {
+		return err
+	}
+	kvs.MergeVC(util.BecomeSyncMap(log.Truncated()))
+	kvs.wal = log
+	util.IPrintf("Replayed %d records of the write-ahead log, vectorclock %v", replayed, util.BecomeMap(kvs.vectorclock))
+	return nil
+}
+
+// writeAhead requires applyMu
+func (kvs *KVServer) writeAhead(logs []config.Log, vc map[string]int32, ts int64, origin string) {
+	if kvs.wal == nil {
+		return
+	}
+	record := wal.Record{
+		Logs:        logs,
+		VectorClock: vc,
+		HLC:         ts,
+		Origin:      origin,
+	}
+	if _, err := kvs.wal.Append(record); err != nil {
+		util.EPrintf("writeAhead %v failed: %v", logs, err)
+	}
+}
+
+// nextWALSeq returns the sequence number of the next record, requires applyMu
+func (kvs *KVServer) nextWALSeq() uint64 {
+	if kvs.wal == nil {
+		return 0
+	}
+	return kvs.wal.LastSeq() + 1
+}
+
+// truncateWAL deletes the segments of causally stable writes, it subscribes to the stable frontier
+func (kvs *KVServer) truncateWAL(frontier map[string]int32) {
+	// the records of deferred puts are needed until the puts are synced
+	var before uint64 = math.MaxUint64
+	kvs.deferredMu.Lock()
+	for _, dp := range kvs.deferred {
+		if dp.walSeq < before {
+			before = dp.walSeq
+		}
+	}
+	kvs.deferredMu.Unlock()
+	removed, err := kvs.wal.Truncate(frontier, before)
+	if err != nil {
+		util.EPrintf("truncateWAL failed: %v", err)
+		return
+	}
+	if removed > 0 {
+		util.DPrintf("truncateWAL removed %d segments, stable vectorclock %v", removed, frontier)
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+/*
+	Watch: 订阅key前缀的变更
+	store应用的每个写(客户端的写，以及AppendEntriesInCausal/AppendEntriesInEventual同步过来的写)都产生一个事件
+	最近的事件保存在环形缓冲区中，重连的watcher带上已经见过的vectorclock(from_clock)，先补发没有被它覆盖的事件
+	被挤出缓冲区的事件不被from_clock覆盖时无法补发，返回OutOfRange，客户端需要重新读取之后再watch
+	MultiPut和写事务的所有事件共用一个vectorclock，事件带有它在这次写中的序号(index)，
+	在一次写的中间断开的watcher带上这次写的vectorclock和最后收到的序号(from_write_clock, from_write_index)，这次写剩下的事件也会补发
+*/
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultWatchBuffer = 4096
+	// events queued per watcher, a watcher which falls behind is closed and resumes with from_clock
+	watcherQueue = 1024
+)
+
+type watcher struct {
+	prefix string
+	ch     chan *kvrpc.WatchEvent
+	// closed when the watcher is dropped for being too slow
+	slow chan struct{}
+}
+
+type watchHub struct {
+	mu sync.Mutex
+	// ring buffer of the last events, ring[next] is the oldest once full
+	ring []*kvrpc.WatchEvent
+	next int
+	full bool
+	// merge of the vectorclocks of the events pushed out of the ring
+	evicted  map[string]int32
+	watchers map[*watcher]bool
+}
+
+func newWatchHub(size int) *watchHub {
+	if size < 1 {
+		size = 1
+	}
+	return &watchHub{
+		ring:     make([]*kvrpc.WatchEvent, size),
+		evicted:  make(map[string]int32),
+		watchers: make(map[*watcher]bool),
+	}
+}
+
+// publish is called by applyLogsLocked, it never blocks
+func (h *watchHub) publish(logs []config.Log, vc map[string]int32, ts int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	index := int32(0)
+	for _, log := range logs {
+		event := &kvrpc.WatchEvent{
+			Key:         log.Key,
+			Vectorclock: vc,
+			Hlc:         ts,
+			Index:       index,
+		}
+		switch log.Option {
+		case "Put":
+			event.Type = kvrpc.WatchEvent_PUT
+			event.Value = log.Value
+		case "Delete":
+			event.Type = kvrpc.WatchEvent_DELETE
+		default:
+			continue
+		}
+		index++
+		if old := h.ring[h.next]; h.full && old != nil {
+			for id, counter := range old.Vectorclock {
+				if counter > h.evicted[id] {
+					h.evicted[id] = counter
+				}
+			}
+		}
+		h.ring[h.next] = event
+		h.next = (h.next + 1) % len(h.ring)
+		if h.next == 0 {
+			h.full = true
+		}
+		for w := range h.watchers {
+			if !strings.HasPrefix(log.Key, w.prefix) {
+				continue
+			}
+			select {
+			case w.ch <- event:
+			default:
+				delete(h.watchers, w)
+				close(w.slow)
+			}
+		}
+	}
+}
+
+// subscribe registers a watcher and returns the buffered events of prefix which fromClock does not cover,
+// and the events of the write at writeClock after writeIndex. ok is false if such events have been pushed out of the ring
+func (h *watchHub) subscribe(prefix string, fromClock map[string]int32, writeClock map[string]int32, writeIndex int32) (w *watcher, replay []*kvrpc.WatchEvent, ok bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(fromClock) > 0 {
+		for id, counter := range h.evicted {
+			if counter > fromClock[id] {
+				return nil, nil, false
+			}
+		}
+		// the events of a write are consecutive, the rest of it is in the ring if the last received event is
+		inWrite := func(event *kvrpc.WatchEvent) bool {
+			return len(writeClock) > 0 && sameVersion(event.Vectorclock, writeClock)
+		}
+		resumed := false
+		for i := 0; i < len(h.ring); i++ {
+			event := h.ring[(h.next+i)%len(h.ring)]
+			if event == nil {
+				continue
+			}
+			if inWrite(event) && event.Index == writeIndex {
+				resumed = true
+			}
+			if !strings.HasPrefix(event.Key, prefix) {
+				continue
+			}
+			if clockCovers(fromClock, event.Vectorclock) && !(inWrite(event) && event.Index > writeIndex) {
+				continue
+			}
+			replay = append(replay, event)
+		}
+		if len(writeClock) > 0 && !resumed && clockCovers(h.evicted, writeClock) {
+			return nil, nil, false
+		}
+	}
+	w = &watcher{
+		prefix: prefix,
+		ch:     make(chan *kvrpc.WatchEvent, watcherQueue),
+		slow:   make(chan struct{}),
+	}
+	h.watchers[w] = true
+	return w, replay, true
+}
+
+func (h *watchHub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.watchers, w)
+}
+
+// clockCovers reports whether every entry of vc is not greater than the one of clock
+func clockCovers(clock map[string]int32, vc map[string]int32) bool {
+	for id, counter := range vc {
+		if counter > clock[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func (kvs *KVServer) Watch(in *kvrpc.WatchRequest, stream kvrpc.KV_WatchServer) error {
+	util.DPrintf("Watch %s from %v", in.Prefix, in.FromClock)
+	w, replay, ok := kvs.watches.subscribe(in.Prefix, in.FromClock, in.FromWriteClock, in.FromWriteIndex)
+	if !ok {
+		return status.Error(codes.OutOfRange, "events after from_clock have been discarded, read again and watch")
+	}
+	defer kvs.watches.unsubscribe(w)
+	for _, event := range replay {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-w.slow:
+			return status.Error(codes.ResourceExhausted, "the watcher is too slow, resume with from_clock")
+		case event := <-w.ch:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+/*
+	只写事务
+	事务的所有写共用一个提交vectorclock，按txnPartSize分成若干部分，每个部分作为一个lattice同步给其它节点
+	接收端缓存收到的部分，收齐之后作为一个batch整体应用，读者要么看到事务的全部写，要么一个都看不到
+	收齐的事务按事务id应用(每个id只应用一次)，而不是按vectorclock: 同一个节点之后的写可能先到达，使vectorclock已经覆盖提交的vectorclock
+	此时事务之后又被写过的key保留较新的值
+	超过txnTimeout仍未收齐的事务被丢弃
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/lattices"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/causalrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/rpc/kvrpc"
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTxnPartSize = 64
+	defaultTxnTimeout  = 30 * time.Second
+)
+
+// pendingTxn is a write transaction from another node whose parts have not all arrived
+type pendingTxn struct {
+	parts       [][]config.Log
+	received    int
+	vectorclock map[string]int32
+	hlc         int64
+	origin      string
+	since       time.Time
+}
+
+// txnParts splits the logs of a transaction into lattices of at most txnPartSize writes
+func (kvs *KVServer) txnParts(txnID string, logs []config.Log, vc map[string]int32, ts int64) []*causalrpc.AppendEntriesInCausalRequest {
+	partSize := kvs.txnPartSize
+	if partSize < 1 {
+		partSize = 1
+	}
+	parts := (len(logs) + partSize - 1) / partSize
+	args := make([]*causalrpc.AppendEntriesInCausalRequest, 0, parts)
+	for i := 0; i < parts; i++ {
+		end := (i + 1) * partSize
+		if end > len(logs) {
+			end = len(logs)
+		}
+		ml := lattices.HybridLattice{
+			Vl: lattices.ValueLattice{
+				VectorClock: vc,
+			},
+			Batch:  logs[i*partSize : end],
+			Txn:    txnID,
+			Part:   i,
+			Parts:  parts,
+			HLC:    ts,
+			Origin: kvs.internalAddress,
+		}
+		data, _ := json.Marshal(ml)
+		args = append(args, &causalrpc.AppendEntriesInCausalRequest{
+			MapLattice: data,
+			Version:    1,
+		})
+	}
+	return args
+}
+
+// startWriteTxnInCausal commits the logs at one vectorclock, returns the id of the transaction
+func (kvs *KVServer) startWriteTxnInCausal(logs []config.Log, vcFromClient map[string]int32) string {
+	util.DPrintf("WriteTxn in Start(): %v ", logs)
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	kvs.tickClock(vcFromClient)
+	vc := util.BecomeMap(kvs.vectorclock)
+	now := kvs.clock.Now()
+	// the entry of this node identifies the commit
+	txnID := fmt.Sprintf("%s/%d", kvs.internalAddress, vc[kvs.internalAddress])
+	for _, args := range kvs.txnParts(txnID, logs, vc, now) {
+		for i := 0; i < len(kvs.peers); i++ {
+			if kvs.peers[i] != kvs.internalAddress {
+				go kvs.sendAppendEntriesInCausal(kvs.peers[i], args)
+			}
+		}
+	}
+	kvs.applyLogsLocked(logs, vc, now, kvs.internalAddress)
+	return txnID
+}
+
+// bufferTxnPart keeps a part of a write transaction. complete is true with the whole transaction as one batch lattice
+// once all parts have arrived; ok is false if the part is rejected
+func (kvs *KVServer) bufferTxnPart(ml lattices.HybridLattice) (txn lattices.HybridLattice, complete bool, ok bool) {
+	if ml.Part < 0 || ml.Part >= ml.Parts {
+		util.EPrintf("bufferTxnPart %s: part %d of %d", ml.Txn, ml.Part, ml.Parts)
+		return txn, false, false
+	}
+	kvs.txnMu.Lock()
+	defer kvs.txnMu.Unlock()
+	now := time.Now()
+	for id, pending := range kvs.pendingTxns {
+		if now.Sub(pending.since) > kvs.txnTimeout {
+			util.EPrintf("write transaction %s incomplete after %v, %d of %d parts, dropped", id, kvs.txnTimeout, pending.received, len(pending.parts))
+			delete(kvs.pendingTxns, id)
+		}
+	}
+	for id, applied := range kvs.appliedTxns {
+		// parts are sent once, a duplicate cannot arrive this late
+		if now.Sub(applied) > 2*kvs.txnTimeout {
+			delete(kvs.appliedTxns, id)
+		}
+	}
+	if _, applied := kvs.appliedTxns[ml.Txn]; applied {
+		return txn, false, false
+	}
+	pending, found := kvs.pendingTxns[ml.Txn]
+	if !found {
+		pending = &pendingTxn{
+			parts:       make([][]config.Log, ml.Parts),
+			vectorclock: ml.Vl.VectorClock,
+			hlc:         ml.HLC,
+			origin:      ml.Origin,
+			since:       now,
+		}
+		kvs.pendingTxns[ml.Txn] = pending
+	}
+	if ml.Parts != len(pending.parts) {
+		util.EPrintf("bufferTxnPart %s: %d parts, expected %d", ml.Txn, ml.Parts, len(pending.parts))
+		return txn, false, false
+	}
+	if pending.parts[ml.Part] == nil {
+		pending.parts[ml.Part] = ml.Batch
+		pending.received++
+	}
+	if pending.received < len(pending.parts) {
+		return txn, false, true
+	}
+	delete(kvs.pendingTxns, ml.Txn)
+	kvs.appliedTxns[ml.Txn] = now
+	logs := make([]config.Log, 0, len(pending.parts)*len(ml.Batch))
+	for _, part := range pending.parts {
+		logs = append(logs, part...)
+	}
+	txn = lattices.HybridLattice{
+		Vl: lattices.ValueLattice{
+			VectorClock: pending.vectorclock,
+		},
+		Batch:  logs,
+		HLC:    pending.hlc,
+		Origin: pending.origin,
+	}
+	return txn, true, true
+}
+
+// applyTxnInCausal applies a complete write transaction from another node whether or not the vectorclock covers it,
+// a key with a newer version than the transaction keeps its value
+func (kvs *KVServer) applyTxnInCausal(txn lattices.HybridLattice) bool {
+	kvs.applyMu.Lock()
+	defer kvs.applyMu.Unlock()
+	logs := make([]config.Log, 0, len(txn.Batch))
+	for _, log := range txn.Batch {
+		if version, ok := kvs.store.LatestVersion(log.Key); ok && clockCovers(version.VectorClock, txn.Vl.VectorClock) && !sameVersion(version.VectorClock, txn.Vl.VectorClock) {
+			continue
+		}
+		logs = append(logs, log)
+	}
+	if len(logs) > 0 {
+		kvs.applyLogsLocked(logs, txn.Vl.VectorClock, kvs.remoteTime(txn.HLC), txn.Origin)
+	}
+	kvs.MergeVC(util.BecomeSyncMap(txn.Vl.VectorClock))
+	return true
+}
+
+func (kvs *KVServer) WriteTxnInCausal(ctx context.Context, in *kvrpc.WriteTxnInCausalRequest) (*kvrpc.WriteTxnInCausalResponse, error) {
+	util.DPrintf("WriteTxnInCausal %v %v", in.Pairs, in.Deletes)
+	writeTxnInCausalResponse := new(kvrpc.WriteTxnInCausalResponse)
+	vc, err := kvs.clientClock(in.Session, in.Vectorclock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	vc = kvs.completeClock(vc)
+	logs := putLogs(in.Pairs)
+	for _, key := range in.Deletes {
+		logs = append(logs, config.Log{
+			Option: "Delete",
+			Key:    key,
+		})
+	}
+	if len(logs) == 0 {
+		writeTxnInCausalResponse.Success = true
+		writeTxnInCausalResponse.Vectorclock = util.BecomeMap(kvs.vectorclock)
+		writeTxnInCausalResponse.Session = kvs.sessionToken()
+		return writeTxnInCausalResponse, nil
+	}
+	writeTxnInCausalResponse.TxnId = kvs.startWriteTxnInCausal(logs, vc)
+	writeTxnInCausalResponse.Success = true
+	newVC := util.BecomeMap(kvs.vectorclock)
+	writeTxnInCausalResponse.Vectorclock = newVC
+	writeTxnInCausalResponse.Session = kvs.sessions.Encode(newVC)
+	return writeTxnInCausalResponse, nil
+}
@@ -29,6 +29,17 @@ type ValueLattice struct {
 type HybridLattice struct {
 	Key string
 	Vl  ValueLattice
+	// batch lattice(MultiPut): 所有写共用Vl.VectorClock，接收端整体应用，此时Key和Vl.Log为空
+	Batch []config.Log `json:",omitempty"`
+	// 写事务的一部分: Txn是事务id，Part从0开始，共Parts个部分，所有部分的Vl.VectorClock都是提交的vectorclock
+	// 接收端收齐所有部分之后作为一个batch整体应用
+	Txn   string `json:",omitempty"`
+	Part  int    `json:",omitempty"`
+	Parts int    `json:",omitempty"`
+	// HLC时间戳，写入时由源节点分配，所有节点的版本历史使用同一个时间戳
+	HLC int64 `json:",omitempty"`
+	// 接受这个写的节点的internalAddress
+	Origin string `json:",omitempty"`
 }
 
 func (vl ValueLattice) Reveal() config.Log {
@@ -48,6 +59,14 @@ func (ml HybridLattice) Reveal() config.Log {
 	return ml.Vl.Log
 }
 
+// Logs returns the writes carried by the lattice, the whole batch or the single log
+func (ml HybridLattice) Logs() []config.Log {
+	if len(ml.Batch) > 0 {
+		return ml.Batch
+	}
+	return []config.Log{ml.Vl.Log}
+}
+
 func (ml *HybridLattice) Merge(other HybridLattice) {
 	if util.IsUpper(util.BecomeSyncMap(other.Vl.VectorClock), util.BecomeSyncMap(ml.Vl.VectorClock)) {
 		// other >= vl
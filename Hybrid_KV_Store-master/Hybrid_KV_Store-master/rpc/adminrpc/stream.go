@@ -0,0 +1,68 @@
+package adminrpc
+
+import "io"
+
+// SnapshotReader reads the snapshot file carried by the chunks of a Snapshot stream
+func SnapshotReader(stream ADMIN_SnapshotClient) io.Reader {
+	return &chunkReader{recv: func() ([]byte, error) {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return chunk.Data, nil
+	}}
+}
+
+// RestoreReader reads the snapshot file carried by the chunks of a Restore stream
+func RestoreReader(stream ADMIN_RestoreServer) io.Reader {
+	return &chunkReader{recv: func() ([]byte, error) {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return chunk.Data, nil
+	}}
+}
+
+// SnapshotWriter sends every write as a chunk of a Snapshot stream
+func SnapshotWriter(stream ADMIN_SnapshotServer) io.Writer {
+	return chunkWriter(func(p []byte) error {
+		return stream.Send(&SnapshotChunk{Data: p})
+	})
+}
+
+// RestoreWriter sends every write as a chunk of a Restore stream
+func RestoreWriter(stream ADMIN_RestoreClient) io.Writer {
+	return chunkWriter(func(p []byte) error {
+		return stream.Send(&RestoreChunk{Data: p})
+	})
+}
+
+type chunkReader struct {
+	recv func() ([]byte, error)
+	buf  []byte
+}
+
+// Read returns io.EOF at the end of the stream
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		data, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// chunkWriter sends p before returning, so p may be reused by the caller
+type chunkWriter func(p []byte) error
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -126,6 +126,565 @@ func (x *AppendEntriesInCausalResponse) GetSuccess() bool {
 	return false
 }
 
+type FlushDeferredInCausalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *FlushDeferredInCausalRequest) Reset() {
+	*x = FlushDeferredInCausalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushDeferredInCausalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushDeferredInCausalRequest) ProtoMessage() {}
+
+func (x *FlushDeferredInCausalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushDeferredInCausalRequest.ProtoReflect.Descriptor instead.
+func (*FlushDeferredInCausalRequest) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlushDeferredInCausalRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type FlushDeferredInCausalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	MapLattice []byte `protobuf:"bytes,2,opt,name=map_lattice,json=mapLattice,proto3" json:"map_lattice,omitempty"` // latest value of the deferred puts, empty if nothing deferred
+}
+
+func (x *FlushDeferredInCausalResponse) Reset() {
+	*x = FlushDeferredInCausalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushDeferredInCausalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushDeferredInCausalResponse) ProtoMessage() {}
+
+func (x *FlushDeferredInCausalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushDeferredInCausalResponse.ProtoReflect.Descriptor instead.
+func (*FlushDeferredInCausalResponse) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FlushDeferredInCausalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FlushDeferredInCausalResponse) GetMapLattice() []byte {
+	if x != nil {
+		return x.MapLattice
+	}
+	return nil
+}
+
+type GossipStatsInCausalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Stats []byte `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"` // json of writeless.Counts by key, of a chunk of the keys
+	// the stats of a round are split into chunks that fit in a message, the peer replaces
+	// the stats of the node once every chunk of the round has arrived
+	Round  int64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Chunk  int32 `protobuf:"varint,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Chunks int32 `protobuf:"varint,5,opt,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (x *GossipStatsInCausalRequest) Reset() {
+	*x = GossipStatsInCausalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipStatsInCausalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipStatsInCausalRequest) ProtoMessage() {}
+
+func (x *GossipStatsInCausalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipStatsInCausalRequest.ProtoReflect.Descriptor instead.
+func (*GossipStatsInCausalRequest) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GossipStatsInCausalRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GossipStatsInCausalRequest) GetStats() []byte {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GossipStatsInCausalRequest) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GossipStatsInCausalRequest) GetChunk() int32 {
+	if x != nil {
+		return x.Chunk
+	}
+	return 0
+}
+
+func (x *GossipStatsInCausalRequest) GetChunks() int32 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+type GossipStatsInCausalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *GossipStatsInCausalResponse) Reset() {
+	*x = GossipStatsInCausalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipStatsInCausalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipStatsInCausalResponse) ProtoMessage() {}
+
+func (x *GossipStatsInCausalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipStatsInCausalResponse.ProtoReflect.Descriptor instead.
+func (*GossipStatsInCausalResponse) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GossipStatsInCausalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetNodeInfoInCausalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNodeInfoInCausalRequest) Reset() {
+	*x = GetNodeInfoInCausalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeInfoInCausalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeInfoInCausalRequest) ProtoMessage() {}
+
+func (x *GetNodeInfoInCausalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeInfoInCausalRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeInfoInCausalRequest) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{6}
+}
+
+type GetNodeInfoInCausalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address           string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                                        // kvrpc address for clients
+	InternalAddress   string   `protobuf:"bytes,2,opt,name=internal_address,json=internalAddress,proto3" json:"internal_address,omitempty"` // also the id of the node in the vectorclock
+	TcpAddress        string   `protobuf:"bytes,3,opt,name=tcp_address,json=tcpAddress,proto3" json:"tcp_address,omitempty"`
+	RespAddress       string   `protobuf:"bytes,4,opt,name=resp_address,json=respAddress,proto3" json:"resp_address,omitempty"`
+	HttpAddress       string   `protobuf:"bytes,5,opt,name=http_address,json=httpAddress,proto3" json:"http_address,omitempty"`
+	ConsistencyLevels []string `protobuf:"bytes,6,rep,name=consistency_levels,json=consistencyLevels,proto3" json:"consistency_levels,omitempty"`
+}
+
+func (x *GetNodeInfoInCausalResponse) Reset() {
+	*x = GetNodeInfoInCausalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeInfoInCausalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeInfoInCausalResponse) ProtoMessage() {}
+
+func (x *GetNodeInfoInCausalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeInfoInCausalResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeInfoInCausalResponse) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetNodeInfoInCausalResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetNodeInfoInCausalResponse) GetInternalAddress() string {
+	if x != nil {
+		return x.InternalAddress
+	}
+	return ""
+}
+
+func (x *GetNodeInfoInCausalResponse) GetTcpAddress() string {
+	if x != nil {
+		return x.TcpAddress
+	}
+	return ""
+}
+
+func (x *GetNodeInfoInCausalResponse) GetRespAddress() string {
+	if x != nil {
+		return x.RespAddress
+	}
+	return ""
+}
+
+func (x *GetNodeInfoInCausalResponse) GetHttpAddress() string {
+	if x != nil {
+		return x.HttpAddress
+	}
+	return ""
+}
+
+func (x *GetNodeInfoInCausalResponse) GetConsistencyLevels() []string {
+	if x != nil {
+		return x.ConsistencyLevels
+	}
+	return nil
+}
+
+type ForwardCompareAndSetInCausalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cas []byte `protobuf:"bytes,1,opt,name=cas,proto3" json:"cas,omitempty"` // json of the condition and the write
+}
+
+func (x *ForwardCompareAndSetInCausalRequest) Reset() {
+	*x = ForwardCompareAndSetInCausalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardCompareAndSetInCausalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardCompareAndSetInCausalRequest) ProtoMessage() {}
+
+func (x *ForwardCompareAndSetInCausalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardCompareAndSetInCausalRequest.ProtoReflect.Descriptor instead.
+func (*ForwardCompareAndSetInCausalRequest) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ForwardCompareAndSetInCausalRequest) GetCas() []byte {
+	if x != nil {
+		return x.Cas
+	}
+	return nil
+}
+
+type ForwardCompareAndSetInCausalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // false if this node is not the primary
+	Result  []byte `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`    // json of the swapped flag, value, version and vectorclock
+}
+
+func (x *ForwardCompareAndSetInCausalResponse) Reset() {
+	*x = ForwardCompareAndSetInCausalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardCompareAndSetInCausalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardCompareAndSetInCausalResponse) ProtoMessage() {}
+
+func (x *ForwardCompareAndSetInCausalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardCompareAndSetInCausalResponse.ProtoReflect.Descriptor instead.
+func (*ForwardCompareAndSetInCausalResponse) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ForwardCompareAndSetInCausalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ForwardCompareAndSetInCausalResponse) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GossipClockInCausalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From        string           `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Vectorclock map[string]int32 `protobuf:"bytes,2,rep,name=vectorclock,proto3" json:"vectorclock,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // covers the writes applied on the sender
+}
+
+func (x *GossipClockInCausalRequest) Reset() {
+	*x = GossipClockInCausalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipClockInCausalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipClockInCausalRequest) ProtoMessage() {}
+
+func (x *GossipClockInCausalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipClockInCausalRequest.ProtoReflect.Descriptor instead.
+func (*GossipClockInCausalRequest) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GossipClockInCausalRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GossipClockInCausalRequest) GetVectorclock() map[string]int32 {
+	if x != nil {
+		return x.Vectorclock
+	}
+	return nil
+}
+
+type GossipClockInCausalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *GossipClockInCausalResponse) Reset() {
+	*x = GossipClockInCausalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_causal_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipClockInCausalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipClockInCausalResponse) ProtoMessage() {}
+
+func (x *GossipClockInCausalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_causal_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipClockInCausalResponse.ProtoReflect.Descriptor instead.
+func (*GossipClockInCausalResponse) Descriptor() ([]byte, []int) {
+	return file_causal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GossipClockInCausalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_causal_proto protoreflect.FileDescriptor
 
 var file_causal_proto_rawDesc = []byte{
@@ -139,14 +698,107 @@ var file_causal_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73,
 	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x32, 0x62, 0x0a, 0x06, 0x43, 0x41, 0x55, 0x53, 0x41, 0x4c, 0x12, 0x58,
-	0x0a, 0x15, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49,
-	0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x3b, 0x63,
-	0x61, 0x75, 0x73, 0x61, 0x6c, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x1c, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x44, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5a, 0x0a, 0x1d, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x44,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x61, 0x74, 0x74, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x4c, 0x61, 0x74, 0x74, 0x69,
+	0x63, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x1a, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x22,
+	0x37, 0x0a, 0x1b, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e,
+	0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x22, 0x37, 0x0a, 0x23, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x61, 0x73, 0x22, 0x58, 0x0a, 0x24, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x1a, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x4e, 0x0a, 0x0b, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x47,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x3e, 0x0a, 0x10, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x37, 0x0a, 0x1b, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x32, 0xa7, 0x04, 0x0a, 0x06, 0x43, 0x41, 0x55, 0x53, 0x41, 0x4c, 0x12, 0x58, 0x0a, 0x15, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x43, 0x61,
+	0x75, 0x73, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x12, 0x1d,
+	0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6e,
+	0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x43,
+	0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x13, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e,
+	0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1c, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x49,
+	0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x49, 0x6e,
+	0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e,
+	0x64, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x12, 0x1b, 0x2e,
+	0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x61, 0x75,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f,
+	0x3b, 0x63, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -161,19 +813,41 @@ func file_causal_proto_rawDescGZIP() []byte {
 	return file_causal_proto_rawDescData
 }
 
-var file_causal_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_causal_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_causal_proto_goTypes = []interface{}{
-	(*AppendEntriesInCausalRequest)(nil),  // 0: AppendEntriesInCausalRequest
-	(*AppendEntriesInCausalResponse)(nil), // 1: AppendEntriesInCausalResponse
+	(*AppendEntriesInCausalRequest)(nil),         // 0: AppendEntriesInCausalRequest
+	(*AppendEntriesInCausalResponse)(nil),        // 1: AppendEntriesInCausalResponse
+	(*FlushDeferredInCausalRequest)(nil),         // 2: FlushDeferredInCausalRequest
+	(*FlushDeferredInCausalResponse)(nil),        // 3: FlushDeferredInCausalResponse
+	(*GossipStatsInCausalRequest)(nil),           // 4: GossipStatsInCausalRequest
+	(*GossipStatsInCausalResponse)(nil),          // 5: GossipStatsInCausalResponse
+	(*GetNodeInfoInCausalRequest)(nil),           // 6: GetNodeInfoInCausalRequest
+	(*GetNodeInfoInCausalResponse)(nil),          // 7: GetNodeInfoInCausalResponse
+	(*ForwardCompareAndSetInCausalRequest)(nil),  // 8: ForwardCompareAndSetInCausalRequest
+	(*ForwardCompareAndSetInCausalResponse)(nil), // 9: ForwardCompareAndSetInCausalResponse
+	(*GossipClockInCausalRequest)(nil),           // 10: GossipClockInCausalRequest
+	(*GossipClockInCausalResponse)(nil),          // 11: GossipClockInCausalResponse
+	nil,                                          // 12: GossipClockInCausalRequest.VectorclockEntry
 }
 var file_causal_proto_depIdxs = []int32{
-	0, // 0: CAUSAL.AppendEntriesInCausal:input_type -> AppendEntriesInCausalRequest
-	1, // 1: CAUSAL.AppendEntriesInCausal:output_type -> AppendEntriesInCausalResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	12, // 0: GossipClockInCausalRequest.vectorclock:type_name -> GossipClockInCausalRequest.VectorclockEntry
+	0,  // 1: CAUSAL.AppendEntriesInCausal:input_type -> AppendEntriesInCausalRequest
+	2,  // 2: CAUSAL.FlushDeferredInCausal:input_type -> FlushDeferredInCausalRequest
+	4,  // 3: CAUSAL.GossipStatsInCausal:input_type -> GossipStatsInCausalRequest
+	6,  // 4: CAUSAL.GetNodeInfoInCausal:input_type -> GetNodeInfoInCausalRequest
+	8,  // 5: CAUSAL.ForwardCompareAndSetInCausal:input_type -> ForwardCompareAndSetInCausalRequest
+	10, // 6: CAUSAL.GossipClockInCausal:input_type -> GossipClockInCausalRequest
+	1,  // 7: CAUSAL.AppendEntriesInCausal:output_type -> AppendEntriesInCausalResponse
+	3,  // 8: CAUSAL.FlushDeferredInCausal:output_type -> FlushDeferredInCausalResponse
+	5,  // 9: CAUSAL.GossipStatsInCausal:output_type -> GossipStatsInCausalResponse
+	7,  // 10: CAUSAL.GetNodeInfoInCausal:output_type -> GetNodeInfoInCausalResponse
+	9,  // 11: CAUSAL.ForwardCompareAndSetInCausal:output_type -> ForwardCompareAndSetInCausalResponse
+	11, // 12: CAUSAL.GossipClockInCausal:output_type -> GossipClockInCausalResponse
+	7,  // [7:13] is the sub-list for method output_type
+	1,  // [1:7] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_causal_proto_init() }
@@ -206,6 +880,126 @@ func file_causal_proto_init() {
 				return nil
 			}
 		}
+		file_causal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushDeferredInCausalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushDeferredInCausalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipStatsInCausalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipStatsInCausalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeInfoInCausalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeInfoInCausalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardCompareAndSetInCausalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardCompareAndSetInCausalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipClockInCausalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_causal_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipClockInCausalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -213,7 +1007,7 @@ func file_causal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_causal_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -240,6 +1034,16 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CAUSALClient interface {
 	AppendEntriesInCausal(ctx context.Context, in *AppendEntriesInCausalRequest, opts ...grpc.CallOption) (*AppendEntriesInCausalResponse, error)
+	// a writeless Get on another node pulls the deferred puts of the key
+	FlushDeferredInCausal(ctx context.Context, in *FlushDeferredInCausalRequest, opts ...grpc.CallOption) (*FlushDeferredInCausalResponse, error)
+	// periodic exchange of the writeless access statistics
+	GossipStatsInCausal(ctx context.Context, in *GossipStatsInCausalRequest, opts ...grpc.CallOption) (*GossipStatsInCausalResponse, error)
+	// addresses of a node, collected by GetClusterInfo
+	GetNodeInfoInCausal(ctx context.Context, in *GetNodeInfoInCausalRequest, opts ...grpc.CallOption) (*GetNodeInfoInCausalResponse, error)
+	// a strong compare-and-set is forwarded to the primary peers[0]
+	ForwardCompareAndSetInCausal(ctx context.Context, in *ForwardCompareAndSetInCausalRequest, opts ...grpc.CallOption) (*ForwardCompareAndSetInCausalResponse, error)
+	// periodic exchange of the vectorclocks for the stable frontier
+	GossipClockInCausal(ctx context.Context, in *GossipClockInCausalRequest, opts ...grpc.CallOption) (*GossipClockInCausalResponse, error)
 }
 
 type cAUSALClient struct {
@@ -259,9 +1063,64 @@ func (c *cAUSALClient) AppendEntriesInCausal(ctx context.Context, in *AppendEntr
 	return out, nil
 }
 
+func (c *cAUSALClient) FlushDeferredInCausal(ctx context.Context, in *FlushDeferredInCausalRequest, opts ...grpc.CallOption) (*FlushDeferredInCausalResponse, error) {
+	out := new(FlushDeferredInCausalResponse)
+	err := c.cc.Invoke(ctx, "/CAUSAL/FlushDeferredInCausal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAUSALClient) GossipStatsInCausal(ctx context.Context, in *GossipStatsInCausalRequest, opts ...grpc.CallOption) (*GossipStatsInCausalResponse, error) {
+	out := new(GossipStatsInCausalResponse)
+	err := c.cc.Invoke(ctx, "/CAUSAL/GossipStatsInCausal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAUSALClient) GetNodeInfoInCausal(ctx context.Context, in *GetNodeInfoInCausalRequest, opts ...grpc.CallOption) (*GetNodeInfoInCausalResponse, error) {
+	out := new(GetNodeInfoInCausalResponse)
+	err := c.cc.Invoke(ctx, "/CAUSAL/GetNodeInfoInCausal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAUSALClient) ForwardCompareAndSetInCausal(ctx context.Context, in *ForwardCompareAndSetInCausalRequest, opts ...grpc.CallOption) (*ForwardCompareAndSetInCausalResponse, error) {
+	out := new(ForwardCompareAndSetInCausalResponse)
+	err := c.cc.Invoke(ctx, "/CAUSAL/ForwardCompareAndSetInCausal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAUSALClient) GossipClockInCausal(ctx context.Context, in *GossipClockInCausalRequest, opts ...grpc.CallOption) (*GossipClockInCausalResponse, error) {
+	out := new(GossipClockInCausalResponse)
+	err := c.cc.Invoke(ctx, "/CAUSAL/GossipClockInCausal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CAUSALServer is the server API for CAUSAL service.
 type CAUSALServer interface {
 	AppendEntriesInCausal(context.Context, *AppendEntriesInCausalRequest) (*AppendEntriesInCausalResponse, error)
+	// a writeless Get on another node pulls the deferred puts of the key
+	FlushDeferredInCausal(context.Context, *FlushDeferredInCausalRequest) (*FlushDeferredInCausalResponse, error)
+	// periodic exchange of the writeless access statistics
+	GossipStatsInCausal(context.Context, *GossipStatsInCausalRequest) (*GossipStatsInCausalResponse, error)
+	// addresses of a node, collected by GetClusterInfo
+	GetNodeInfoInCausal(context.Context, *GetNodeInfoInCausalRequest) (*GetNodeInfoInCausalResponse, error)
+	// a strong compare-and-set is forwarded to the primary peers[0]
+	ForwardCompareAndSetInCausal(context.Context, *ForwardCompareAndSetInCausalRequest) (*ForwardCompareAndSetInCausalResponse, error)
+	// periodic exchange of the vectorclocks for the stable frontier
+	GossipClockInCausal(context.Context, *GossipClockInCausalRequest) (*GossipClockInCausalResponse, error)
 }
 
 // UnimplementedCAUSALServer can be embedded to have forward compatible implementations.
@@ -271,6 +1130,21 @@ type UnimplementedCAUSALServer struct {
 func (*UnimplementedCAUSALServer) AppendEntriesInCausal(context.Context, *AppendEntriesInCausalRequest) (*AppendEntriesInCausalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppendEntriesInCausal not implemented")
 }
+func (*UnimplementedCAUSALServer) FlushDeferredInCausal(context.Context, *FlushDeferredInCausalRequest) (*FlushDeferredInCausalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushDeferredInCausal not implemented")
+}
+func (*UnimplementedCAUSALServer) GossipStatsInCausal(context.Context, *GossipStatsInCausalRequest) (*GossipStatsInCausalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GossipStatsInCausal not implemented")
+}
+func (*UnimplementedCAUSALServer) GetNodeInfoInCausal(context.Context, *GetNodeInfoInCausalRequest) (*GetNodeInfoInCausalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfoInCausal not implemented")
+}
+func (*UnimplementedCAUSALServer) ForwardCompareAndSetInCausal(context.Context, *ForwardCompareAndSetInCausalRequest) (*ForwardCompareAndSetInCausalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardCompareAndSetInCausal not implemented")
+}
+func (*UnimplementedCAUSALServer) GossipClockInCausal(context.Context, *GossipClockInCausalRequest) (*GossipClockInCausalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GossipClockInCausal not implemented")
+}
 
 func RegisterCAUSALServer(s *grpc.Server, srv CAUSALServer) {
 	s.RegisterService(&_CAUSAL_serviceDesc, srv)
@@ -294,6 +1168,96 @@ func _CAUSAL_AppendEntriesInCausal_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CAUSAL_FlushDeferredInCausal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushDeferredInCausalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAUSALServer).FlushDeferredInCausal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CAUSAL/FlushDeferredInCausal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAUSALServer).FlushDeferredInCausal(ctx, req.(*FlushDeferredInCausalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAUSAL_GossipStatsInCausal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipStatsInCausalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAUSALServer).GossipStatsInCausal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CAUSAL/GossipStatsInCausal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAUSALServer).GossipStatsInCausal(ctx, req.(*GossipStatsInCausalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAUSAL_GetNodeInfoInCausal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeInfoInCausalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAUSALServer).GetNodeInfoInCausal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CAUSAL/GetNodeInfoInCausal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAUSALServer).GetNodeInfoInCausal(ctx, req.(*GetNodeInfoInCausalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAUSAL_ForwardCompareAndSetInCausal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardCompareAndSetInCausalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAUSALServer).ForwardCompareAndSetInCausal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CAUSAL/ForwardCompareAndSetInCausal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAUSALServer).ForwardCompareAndSetInCausal(ctx, req.(*ForwardCompareAndSetInCausalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAUSAL_GossipClockInCausal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipClockInCausalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAUSALServer).GossipClockInCausal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CAUSAL/GossipClockInCausal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAUSALServer).GossipClockInCausal(ctx, req.(*GossipClockInCausalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CAUSAL_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "CAUSAL",
 	HandlerType: (*CAUSALServer)(nil),
@@ -302,6 +1266,26 @@ var _CAUSAL_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AppendEntriesInCausal",
 			Handler:    _CAUSAL_AppendEntriesInCausal_Handler,
 		},
+		{
+			MethodName: "FlushDeferredInCausal",
+			Handler:    _CAUSAL_FlushDeferredInCausal_Handler,
+		},
+		{
+			MethodName: "GossipStatsInCausal",
+			Handler:    _CAUSAL_GossipStatsInCausal_Handler,
+		},
+		{
+			MethodName: "GetNodeInfoInCausal",
+			Handler:    _CAUSAL_GetNodeInfoInCausal_Handler,
+		},
+		{
+			MethodName: "ForwardCompareAndSetInCausal",
+			Handler:    _CAUSAL_ForwardCompareAndSetInCausal_Handler,
+		},
+		{
+			MethodName: "GossipClockInCausal",
+			Handler:    _CAUSAL_GossipClockInCausal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "causal.proto",
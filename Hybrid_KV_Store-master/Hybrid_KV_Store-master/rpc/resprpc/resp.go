@@ -0,0 +1,180 @@
+package resprpc
+
+/*
+	Redis RESP2/RESP3协议的编解码，服务端见 kvstore/kvserver/resp.go
+	请求为bulk string数组(redis-cli、go-redis)，或者一行空格分隔的inline命令(telnet)
+	默认使用RESP2，客户端通过 HELLO 3 切换到RESP3
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// max elements of a command
+	MaxArgs = 1024 * 1024
+	// max bytes of a bulk string, same as proto-max-bulk-len of redis
+	MaxBulk = 512 * 1024 * 1024
+	// max bytes of all bulk strings of a command
+	MaxCommand = 512 * 1024 * 1024
+)
+
+var ErrProtocol = errors.New("resprpc: protocol error")
+
+type Reader struct {
+	r *bufio.Reader
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Buffered returns the bytes of pipelined commands already read from the connection
+func (r *Reader) Buffered() int {
+	return r.r.Buffered()
+}
+
+func (r *Reader) readLine() (string, error) {
+	line, err := r.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// ReadCommand returns the name and the arguments of the next command, empty inline lines are skipped
+func (r *Reader) ReadCommand() ([]string, error) {
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if line == "" {
+			continue
+		}
+		if line[0] != '*' {
+			// a line of only spaces is empty too
+			if args := strings.Fields(line); len(args) > 0 {
+				return args, nil
+			}
+			continue
+		}
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n > MaxArgs {
+			return nil, ErrProtocol
+		}
+		if n <= 0 {
+			continue
+		}
+		// like the bulk strings the arguments grow with what is received, not with the announced count
+		args := make([]string, 0, minInt(n, 16))
+		remaining := MaxCommand
+		for i := 0; i < n; i++ {
+			arg, err := r.readBulk(remaining)
+			if err != nil {
+				return nil, err
+			}
+			remaining -= len(arg)
+			args = append(args, arg)
+		}
+		return args, nil
+	}
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// readBulk reads a bulk string of at most limit bytes
+func (r *Reader) readBulk(limit int) (string, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return "", err
+	}
+	if len(line) == 0 || line[0] != '$' {
+		return "", ErrProtocol
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 0 || n > MaxBulk || n > limit {
+		return "", ErrProtocol
+	}
+	// the buffer grows with the bytes actually received, a length announcing a large string does not allocate it up front
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r.r, int64(n)+2); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", err
+	}
+	return string(buf.Bytes()[:n]), nil
+}
+
+// Writer buffers the replies, Flush must be called before waiting for the next command
+type Writer struct {
+	w *bufio.Writer
+	// 2 or 3
+	Proto int
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w), Proto: 2}
+}
+
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+func (w *Writer) WriteSimple(s string) {
+	w.w.WriteString("+" + s + "\r\n")
+}
+
+// WriteError writes msg as is, it should start with an error code such as ERR
+func (w *Writer) WriteError(msg string) {
+	w.w.WriteString("-" + msg + "\r\n")
+}
+
+func (w *Writer) WriteInt(n int64) {
+	w.w.WriteString(":" + strconv.FormatInt(n, 10) + "\r\n")
+}
+
+func (w *Writer) WriteBulk(b []byte) {
+	fmt.Fprintf(w.w, "$%d\r\n", len(b))
+	w.w.Write(b)
+	w.w.WriteString("\r\n")
+}
+
+func (w *Writer) WriteBulkString(s string) {
+	w.WriteBulk([]byte(s))
+}
+
+// WriteNull writes the null bulk string of RESP2 or the null of RESP3
+func (w *Writer) WriteNull() {
+	if w.Proto >= 3 {
+		w.w.WriteString("_\r\n")
+	} else {
+		w.w.WriteString("$-1\r\n")
+	}
+}
+
+// WriteArray writes the header of an array with n elements
+func (w *Writer) WriteArray(n int) {
+	w.w.WriteString("*" + strconv.Itoa(n) + "\r\n")
+}
+
+// WriteMap writes the header of a map with n pairs, a flat array of 2n elements in RESP2
+func (w *Writer) WriteMap(n int) {
+	if w.Proto >= 3 {
+		w.w.WriteString("%" + strconv.Itoa(n) + "\r\n")
+	} else {
+		w.WriteArray(2 * n)
+	}
+}
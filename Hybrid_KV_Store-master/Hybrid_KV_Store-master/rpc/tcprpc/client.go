@@ -0,0 +1,114 @@
+package tcprpc
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Client of the framed TCP protocol, Send and Recv can be used for pipelining
+type Client struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	mu     sync.Mutex
+	nextID uint32
+	codec  Codec
+}
+
+func Dial(address string, timeout time.Duration) (*Client, error) {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		codec:  jsonCodec{},
+	}, nil
+}
+
+// DialEncoding connects and negotiates the payload encoding, encodings are in order of preference
+func DialEncoding(address string, timeout time.Duration, encodings ...string) (*Client, error) {
+	c, err := Dial(address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.handshake(strings.Join(encodings, ",")); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Client) handshake(offered string) error {
+	if err := WriteFrame(c.conn, &Frame{Kind: KindHello, Payload: []byte(offered)}); err != nil {
+		return err
+	}
+	f, err := ReadFrame(c.reader)
+	if err != nil {
+		return err
+	}
+	if f.Kind != KindHello {
+		return fmt.Errorf("tcprpc: expect hello frame, got kind %d", f.Kind)
+	}
+	codec, ok := codecs[string(f.Payload)]
+	if !ok {
+		return fmt.Errorf("tcprpc: server chose unknown encoding %q", f.Payload)
+	}
+	c.codec = codec
+	return nil
+}
+
+// Encoding returns the name of the negotiated encoding
+func (c *Client) Encoding() string {
+	return c.codec.Name()
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Send writes one request and returns its request id
+func (c *Client) Send(req *Request) (uint32, error) {
+	payload, err := c.codec.MarshalRequest(req)
+	if err != nil {
+		return 0, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	id := c.nextID
+	return id, WriteFrame(c.conn, &Frame{Kind: KindMessage, RequestID: id, Payload: payload})
+}
+
+// Recv reads the next response, responses come in the order of the requests
+func (c *Client) Recv() (uint32, *Response, error) {
+	f, err := ReadFrame(c.reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	var resp Response
+	if err := c.codec.UnmarshalResponse(f.Payload, &resp); err != nil {
+		return f.RequestID, nil, err
+	}
+	return f.RequestID, &resp, nil
+}
+
+// Call sends one request and waits for its response, it must not be mixed with concurrent Send/Recv
+func (c *Client) Call(req *Request) (*Response, error) {
+	id, err := c.Send(req)
+	if err != nil {
+		return nil, err
+	}
+	respID, resp, err := c.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if respID != id {
+		return nil, fmt.Errorf("tcprpc: response %d does not match request %d", respID, id)
+	}
+	return resp, nil
+}
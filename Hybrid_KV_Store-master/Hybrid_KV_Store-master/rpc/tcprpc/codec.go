@@ -0,0 +1,137 @@
+package tcprpc
+
+/*
+	消息编码，每个连接通过握手协商:
+	客户端的第一帧为 KindHello，payload为按优先级排列的编码名，逗号分隔，例如 "proto,json"
+	服务端回复一个 KindHello 帧，payload为选中的编码名；没有握手的连接使用JSON
+*/
+
+import (
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	EncodingJSON  = "json"
+	EncodingProto = "proto"
+)
+
+type Codec interface {
+	Name() string
+	MarshalRequest(req *Request) ([]byte, error)
+	UnmarshalRequest(data []byte, req *Request) error
+	MarshalResponse(resp *Response) ([]byte, error)
+	UnmarshalResponse(data []byte, resp *Response) error
+}
+
+var codecs = map[string]Codec{
+	EncodingJSON:  jsonCodec{},
+	EncodingProto: protoCodec{},
+}
+
+// Negotiate picks the first offered encoding this side supports, JSON if there is none
+func Negotiate(offered string) Codec {
+	for _, name := range strings.Split(offered, ",") {
+		if c, ok := codecs[strings.TrimSpace(name)]; ok {
+			return c
+		}
+	}
+	return jsonCodec{}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return EncodingJSON }
+
+func (jsonCodec) MarshalRequest(req *Request) ([]byte, error) { return json.Marshal(req) }
+
+func (jsonCodec) UnmarshalRequest(data []byte, req *Request) error { return json.Unmarshal(data, req) }
+
+func (jsonCodec) MarshalResponse(resp *Response) ([]byte, error) { return json.Marshal(resp) }
+
+func (jsonCodec) UnmarshalResponse(data []byte, resp *Response) error {
+	return json.Unmarshal(data, resp)
+}
+
+// protobuf encoding of TCPRequest/TCPResponse, see tcp.proto
+type protoCodec struct{}
+
+func (protoCodec) Name() string { return EncodingProto }
+
+func (protoCodec) MarshalRequest(req *Request) ([]byte, error) {
+	return proto.Marshal(&TCPRequest{
+		Consistency:     req.Consistency,
+		Operation:       req.Operation,
+		Key:             req.Key,
+		Value:           req.Value,
+		VectorClock:     req.VectorClock,
+		Session:         req.Session,
+		Keys:            req.Keys,
+		Values:          req.Values,
+		Condition:       req.Condition,
+		ExpectedValue:   req.ExpectedValue,
+		ExpectedVersion: req.ExpectedVersion,
+	})
+}
+
+func (protoCodec) UnmarshalRequest(data []byte, req *Request) error {
+	var m TCPRequest
+	if err := proto.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	req.Consistency = m.Consistency
+	req.Operation = m.Operation
+	req.Key = m.Key
+	req.Value = m.Value
+	req.VectorClock = m.VectorClock
+	req.Session = m.Session
+	req.Keys = m.Keys
+	req.Values = m.Values
+	req.Condition = m.Condition
+	req.ExpectedValue = m.ExpectedValue
+	req.ExpectedVersion = m.ExpectedVersion
+	return nil
+}
+
+func (protoCodec) MarshalResponse(resp *Response) ([]byte, error) {
+	return proto.Marshal(&TCPResponse{
+		Operation:    resp.Operation,
+		Key:          resp.Key,
+		Value:        resp.Value,
+		VectorClock:  resp.VectorClock,
+		Success:      resp.Success,
+		Stale:        resp.Stale,
+		LaggingNodes: resp.LaggingNodes,
+		Error:        resp.Error,
+		Session:      resp.Session,
+		Keys:         resp.Keys,
+		Values:       resp.Values,
+		Found:        resp.Found,
+		Swapped:      resp.Swapped,
+		Version:      resp.Version,
+	})
+}
+
+func (protoCodec) UnmarshalResponse(data []byte, resp *Response) error {
+	var m TCPResponse
+	if err := proto.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	resp.Operation = m.Operation
+	resp.Key = m.Key
+	resp.Value = m.Value
+	resp.VectorClock = m.VectorClock
+	resp.Success = m.Success
+	resp.Stale = m.Stale
+	resp.LaggingNodes = m.LaggingNodes
+	resp.Error = m.Error
+	resp.Session = m.Session
+	resp.Keys = m.Keys
+	resp.Values = m.Values
+	resp.Found = m.Found
+	resp.Swapped = m.Swapped
+	resp.Version = m.Version
+	return nil
+}
@@ -0,0 +1,136 @@
+package tcprpc
+
+/*
+	原生TCP协议，面向wasm runtime中的客户端
+
+	帧格式(大端序)，头部共12字节:
+	+-------+---------+------+----------+------------+--------+---------+
+	| magic | version | kind | reserved | request id | length | payload |
+	| 1B    | 1B      | 1B   | 1B       | 4B         | 4B     | length  |
+	+-------+---------+------+----------+------------+--------+---------+
+	magic固定为0xFD，服务端据此区分新的帧协议和旧的裸JSON协议(第一个字节为'{')
+	响应帧回显请求的request id，同一连接上的请求按顺序处理，客户端可以流水线发送
+	payload的编码(JSON或protobuf)在连接建立时通过 KindHello 帧协商，见 codec.go
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	Magic   byte = 0xFD
+	Version byte = 1
+	// size of the frame header
+	HeaderSize = 12
+	// frames with larger payload are rejected
+	MaxPayload = 64 * 1024 * 1024
+)
+
+// kind of a frame
+const (
+	KindMessage byte = iota
+	// encoding handshake, see codec.go
+	KindHello
+)
+
+var ErrBadMagic = errors.New("tcprpc: bad magic")
+
+type Frame struct {
+	Version   byte
+	Kind      byte
+	RequestID uint32
+	Payload   []byte
+}
+
+// ReadFrame reads exactly one frame, partial reads are handled by io.ReadFull
+func ReadFrame(r io.Reader) (*Frame, error) {
+	var header [HeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	if header[0] != Magic {
+		return nil, ErrBadMagic
+	}
+	if header[1] != Version {
+		return nil, fmt.Errorf("tcprpc: unsupported version %d", header[1])
+	}
+	length := binary.BigEndian.Uint32(header[8:12])
+	if length > MaxPayload {
+		return nil, fmt.Errorf("tcprpc: payload of %d bytes is too large", length)
+	}
+	// the buffer grows with the bytes actually received, a header announcing a large payload does not allocate it up front
+	var payload bytes.Buffer
+	if _, err := io.CopyN(&payload, r, int64(length)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	f := &Frame{
+		Version:   header[1],
+		Kind:      header[2],
+		RequestID: binary.BigEndian.Uint32(header[4:8]),
+		Payload:   payload.Bytes(),
+	}
+	return f, nil
+}
+
+// WriteFrame writes the header and the payload with a single Write
+func WriteFrame(w io.Writer, f *Frame) error {
+	if len(f.Payload) > MaxPayload {
+		return fmt.Errorf("tcprpc: payload of %d bytes is too large", len(f.Payload))
+	}
+	buf := make([]byte, HeaderSize+len(f.Payload))
+	buf[0] = Magic
+	buf[1] = Version
+	buf[2] = f.Kind
+	binary.BigEndian.PutUint32(buf[4:8], f.RequestID)
+	binary.BigEndian.PutUint32(buf[8:12], uint32(len(f.Payload)))
+	copy(buf[HeaderSize:], f.Payload)
+	_, err := w.Write(buf)
+	return err
+}
+
+// TCP Message struct
+type Request struct {
+	Consistency string           `json:"consistency"`
+	Operation   string           `json:"operation"`
+	Key         string           `json:"key"`
+	Value       string           `json:"value"`
+	VectorClock map[string]int32 `json:"vector_clock"`
+	// session token returned by the server, used instead of vector_clock if set
+	Session string `json:"session,omitempty"`
+	// MultiGet: keys; MultiPut: keys and values of the same length, applied in order
+	Keys   []string `json:"keys,omitempty"`
+	Values []string `json:"values,omitempty"`
+	// CompareAndSet: condition is value, version or absent
+	Condition       string           `json:"condition,omitempty"`
+	ExpectedValue   string           `json:"expected_value,omitempty"`
+	ExpectedVersion map[string]int32 `json:"expected_version,omitempty"`
+}
+
+type Response struct {
+	Operation   string           `json:"operation"`
+	Key         string           `json:"key"`
+	Value       string           `json:"value"`
+	VectorClock map[string]int32 `json:"vector_clock"`
+	Success     bool             `json:"success"`
+	// only for GetInWritelessCausal: replicas which did not answer the pull of deferred puts
+	Stale        bool     `json:"stale,omitempty"`
+	LaggingNodes []string `json:"lagging_nodes,omitempty"`
+	// set if the request can not be served, e.g. unknown consistency
+	Error string `json:"error,omitempty"`
+	// session token of the causal context after this request
+	Session string `json:"session,omitempty"`
+	// only for MultiGet: values in the order of keys, Found is false for a missing key
+	Keys   []string `json:"keys,omitempty"`
+	Values []string `json:"values,omitempty"`
+	Found  []bool   `json:"found,omitempty"`
+	// only for CompareAndSet: Value and Version are the current ones, Version is empty if the key does not exist
+	Swapped bool             `json:"swapped,omitempty"`
+	Version map[string]int32 `json:"version,omitempty"`
+}
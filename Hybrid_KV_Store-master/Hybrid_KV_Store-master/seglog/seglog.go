@@ -0,0 +1,421 @@
+package seglog
+
+/*
+	分段日志: 记录按序号追加到目录下的段文件中，段文件写满SegmentBytes之后切换到新的段
+	段文件名是其第一条记录的序号(16位十六进制) + ".seg"
+	记录格式(小端): length uint32 | crc32c(seq和data) uint32 | seq uint64 | data
+	崩溃时段的尾部可能是不完整的记录或者全是0，读取时忽略(Open之后日志在新的段中继续)；其它位置校验失败返回ErrCorrupt
+*/
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	segmentExt = ".seg"
+	headerSize = 16
+	// records larger than this are treated as corrupted lengths
+	maxRecordSize = 64 * 1024 * 1024
+)
+
+// SyncPolicy decides when appended records reach the disk
+type SyncPolicy int
+
+const (
+	// records are flushed to the OS every SyncInterval, never fsynced
+	SyncNone SyncPolicy = iota
+	// records are flushed and fsynced every SyncInterval
+	SyncBatch
+	// every Append is flushed and fsynced before it returns
+	SyncAlways
+)
+
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch s {
+	case "none":
+		return SyncNone, nil
+	case "batch":
+		return SyncBatch, nil
+	case "always":
+		return SyncAlways, nil
+	}
+	return SyncNone, fmt.Errorf("seglog: unknown sync policy %q", s)
+}
+
+var (
+	ErrCorrupt = errors.New("seglog: corrupted record")
+	ErrClosed  = errors.New("seglog: closed")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+type Options struct {
+	// size of a segment before the log rolls to a new one, 64MB if 0
+	SegmentBytes int64
+	Sync         SyncPolicy
+	// interval of SyncNone and SyncBatch, 1s if 0
+	SyncInterval time.Duration
+	// the oldest segments beyond MaxSegments are deleted when the log rolls, 0 keeps all
+	MaxSegments int
+}
+
+// Log is safe for concurrent use
+type Log struct {
+	mu      sync.Mutex
+	dir     string
+	opts    Options
+	f       *os.File
+	w       *bufio.Writer
+	segSize int64
+	// sequence number of the first record of the current segment
+	first uint64
+	// sequence number of the next record
+	next   uint64
+	closed chan struct{}
+	err    error
+}
+
+// Open continues the log in dir after its last record, a new segment is started
+func Open(dir string, opts Options) (*Log, error) {
+	if opts.SegmentBytes <= 0 {
+		opts.SegmentBytes = 64 * 1024 * 1024
+	}
+	if opts.SyncInterval <= 0 {
+		opts.SyncInterval = time.Second
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	l := &Log{
+		dir:    dir,
+		opts:   opts,
+		next:   1,
+		closed: make(chan struct{}),
+	}
+	segments, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) > 0 {
+		last := segments[len(segments)-1]
+		l.next = last
+		err := readSegment(filepath.Join(dir, segmentName(last)), func(seq uint64, data []byte) error {
+			l.next = seq + 1
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := l.roll(); err != nil {
+		return nil, err
+	}
+	if opts.Sync != SyncAlways {
+		go l.syncLoop()
+	}
+	return l, nil
+}
+
+func segmentName(first uint64) string {
+	return fmt.Sprintf("%016x%s", first, segmentExt)
+}
+
+// listSegments returns the first sequence numbers of the segments in dir, in order
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	segments := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 16, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, first)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+// Segments returns the first sequence numbers of the segments of the log in dir, in order
+func Segments(dir string) ([]uint64, error) {
+	return listSegments(dir)
+}
+
+// roll closes the current segment and starts a new one at l.next, requires mu
+func (l *Log) roll() error {
+	if l.f != nil {
+		if err := l.flushLocked(true); err != nil {
+			return err
+		}
+		l.f.Close()
+	}
+	f, err := os.OpenFile(filepath.Join(l.dir, segmentName(l.next)), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	l.f = f
+	l.w = bufio.NewWriterSize(f, 64*1024)
+	l.segSize = 0
+	l.first = l.next
+	if l.opts.MaxSegments > 0 {
+		if _, err := l.keepSegmentsLocked(l.opts.MaxSegments); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Append writes a record and returns its sequence number
+func (l *Log) Append(data []byte) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return 0, ErrClosed
+	}
+	if l.err != nil {
+		return 0, l.err
+	}
+	if l.segSize > 0 && l.segSize+int64(headerSize+len(data)) > l.opts.SegmentBytes {
+		if err := l.roll(); err != nil {
+			l.err = err
+			return 0, err
+		}
+	}
+	seq := l.next
+	var header [headerSize]byte
+	binary.LittleEndian.PutUint32(header[0:4], uint32(len(data)))
+	binary.LittleEndian.PutUint64(header[8:16], seq)
+	crc := crc32.Update(0, crcTable, header[8:16])
+	crc = crc32.Update(crc, crcTable, data)
+	binary.LittleEndian.PutUint32(header[4:8], crc)
+	if _, err := l.w.Write(header[:]); err != nil {
+		l.err = err
+		return 0, err
+	}
+	if _, err := l.w.Write(data); err != nil {
+		l.err = err
+		return 0, err
+	}
+	l.next++
+	l.segSize += int64(headerSize + len(data))
+	if l.opts.Sync == SyncAlways {
+		if err := l.flushLocked(true); err != nil {
+			l.err = err
+			return 0, err
+		}
+	}
+	return seq, nil
+}
+
+// LastSeq returns the sequence number of the last appended record, 0 if none
+func (l *Log) LastSeq() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.next - 1
+}
+
+// CurrentSegment returns the first sequence number of the segment being appended
+func (l *Log) CurrentSegment() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.first
+}
+
+func (l *Log) flushLocked(fsync bool) error {
+	if err := l.w.Flush(); err != nil {
+		return err
+	}
+	if fsync {
+		return l.f.Sync()
+	}
+	return nil
+}
+
+// Sync flushes and fsyncs the appended records
+func (l *Log) Sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return ErrClosed
+	}
+	return l.flushLocked(true)
+}
+
+func (l *Log) syncLoop() {
+	ticker := time.NewTicker(l.opts.SyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.closed:
+			return
+		case <-ticker.C:
+		}
+		l.mu.Lock()
+		if l.f != nil && l.err == nil {
+			if err := l.flushLocked(l.opts.Sync == SyncBatch); err != nil {
+				l.err = err
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+// Close syncs and closes the log
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return nil
+	}
+	close(l.closed)
+	err := l.flushLocked(true)
+	l.f.Close()
+	l.f = nil
+	return err
+}
+
+// RemoveBefore deletes the segments whose records all have a sequence number lower than seq,
+// the current segment is kept. Returns the number of deleted segments
+func (l *Log) RemoveBefore(seq uint64) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	segments, err := listSegments(l.dir)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for i := 0; i+1 < len(segments); i++ {
+		// the records of segments[i] end before the first record of segments[i+1]
+		if segments[i+1] > seq {
+			break
+		}
+		if err := os.Remove(filepath.Join(l.dir, segmentName(segments[i]))); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// keepSegmentsLocked deletes the oldest segments beyond max, requires mu
+func (l *Log) keepSegmentsLocked(max int) (int, error) {
+	segments, err := listSegments(l.dir)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for i := 0; i < len(segments)-max; i++ {
+		if err := os.Remove(filepath.Join(l.dir, segmentName(segments[i]))); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Read calls fn for every record of the log in dir with a sequence number not lower than from, in order.
+// Records being appended concurrently may not be visible
+func Read(dir string, from uint64, fn func(seq uint64, data []byte) error) error {
+	segments, err := listSegments(dir)
+	if err != nil {
+		return err
+	}
+	for i, first := range segments {
+		if i+1 < len(segments) && segments[i+1] <= from {
+			continue
+		}
+		err := readSegment(filepath.Join(dir, segmentName(first)), func(seq uint64, data []byte) error {
+			if seq < from {
+				return nil
+			}
+			return fn(seq, data)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSegment calls fn for every record of the segment file. A crash may leave a torn last record,
+// or a tail of zeros, in any segment: the log continues in a new segment after Open
+func readSegment(path string, fn func(seq uint64, data []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReaderSize(f, 64*1024)
+	var header [headerSize]byte
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return nil
+			}
+			return err
+		}
+		seq := binary.LittleEndian.Uint64(header[8:16])
+		length := binary.LittleEndian.Uint32(header[0:4])
+		if length > maxRecordSize {
+			if zeroTail(r, header[:]) {
+				return nil
+			}
+			return fmt.Errorf("%w: %s: length %d", ErrCorrupt, path, length)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(r, data); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return nil
+			}
+			return err
+		}
+		crc := crc32.Update(0, crcTable, header[8:16])
+		crc = crc32.Update(crc, crcTable, data)
+		if crc != binary.LittleEndian.Uint32(header[4:8]) {
+			if zeroTail(r, header[:], data) {
+				return nil
+			}
+			return fmt.Errorf("%w: %s: checksum of record %d", ErrCorrupt, path, seq)
+		}
+		if err := fn(seq, data); err != nil {
+			return err
+		}
+	}
+}
+
+// zeroTail reports whether the bytes read so far and the rest of r are all zero
+func zeroTail(r *bufio.Reader, read ...[]byte) bool {
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		return false
+	}
+	for _, b := range append(read, rest) {
+		for _, x := range b {
+			if x != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+package seglog
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// appendRecords writes n records "record-<seq>" and closes the log
+func appendRecords(t *testing.T, dir string, opts Options, n int) {
+	t.Helper()
+	l, err := Open(dir, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		seq, err := l.Append([]byte(fmt.Sprintf("record-%d", l.LastSeq()+1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seq != l.LastSeq() {
+			t.Fatalf("Append returned %d, LastSeq %d", seq, l.LastSeq())
+		}
+	}
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readAll returns the sequence numbers read from seq from
+func readAll(dir string, from uint64) ([]uint64, error) {
+	var seqs []uint64
+	err := Read(dir, from, func(seq uint64, data []byte) error {
+		if string(data) != fmt.Sprintf("record-%d", seq) {
+			return fmt.Errorf("record %d is %q", seq, data)
+		}
+		seqs = append(seqs, seq)
+		return nil
+	})
+	return seqs, err
+}
+
+func checkSeqs(t *testing.T, seqs []uint64, first uint64, last uint64) {
+	t.Helper()
+	if uint64(len(seqs)) != last-first+1 {
+		t.Fatalf("read %d records, expected %d to %d", len(seqs), first, last)
+	}
+	for i, seq := range seqs {
+		if seq != first+uint64(i) {
+			t.Fatalf("record %d has seq %d, expected %d", i, seq, first+uint64(i))
+		}
+	}
+}
+
+// lastSegment returns the path of the last segment which holds records
+func lastSegment(t *testing.T, dir string) string {
+	t.Helper()
+	segments, err := Segments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := len(segments) - 1; i >= 0; i-- {
+		path := filepath.Join(dir, segmentName(segments[i]))
+		if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+			return path
+		}
+	}
+	t.Fatal("no segment with records")
+	return ""
+}
+
+func TestRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{SegmentBytes: 128, Sync: SyncAlways}
+	appendRecords(t, dir, opts, 50)
+	if segments, _ := Segments(dir); len(segments) < 2 {
+		t.Fatalf("%d segments, the log did not roll", len(segments))
+	}
+	seqs, err := readAll(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSeqs(t, seqs, 1, 50)
+	seqs, err = readAll(dir, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSeqs(t, seqs, 20, 50)
+
+	// the log continues after its last record
+	appendRecords(t, dir, opts, 5)
+	seqs, err = readAll(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSeqs(t, seqs, 1, 55)
+}
+
+func TestTornTail(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{Sync: SyncAlways}
+	appendRecords(t, dir, opts, 10)
+	path := lastSegment(t, dir)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// cut the last record in the middle of its data
+	if err := os.Truncate(path, info.Size()-3); err != nil {
+		t.Fatal(err)
+	}
+	seqs, err := readAll(dir, 1)
+	if err != nil {
+		t.Fatalf("torn tail: %v", err)
+	}
+	checkSeqs(t, seqs, 1, 9)
+
+	// the torn record is lost, its sequence number is written again
+	appendRecords(t, dir, opts, 1)
+	seqs, err = readAll(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSeqs(t, seqs, 1, 10)
+}
+
+func TestZeroTail(t *testing.T) {
+	dir := t.TempDir()
+	appendRecords(t, dir, Options{Sync: SyncAlways}, 10)
+	f, err := os.OpenFile(lastSegment(t, dir), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write(make([]byte, 100))
+	f.Close()
+	seqs, err := readAll(dir, 1)
+	if err != nil {
+		t.Fatalf("zero tail: %v", err)
+	}
+	checkSeqs(t, seqs, 1, 10)
+}
+
+func TestCorruption(t *testing.T) {
+	dir := t.TempDir()
+	appendRecords(t, dir, Options{Sync: SyncAlways}, 10)
+	path := lastSegment(t, dir)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, offset := range map[string]int{
+		// data of the first record
+		"checksum": headerSize,
+		// high byte of the length of the first record
+		"length": 3,
+	} {
+		corrupted := append([]byte{}, data...)
+		corrupted[offset] ^= 0xff
+		if err := os.WriteFile(path, corrupted, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := readAll(dir, 1); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("%s: read returned %v, expected ErrCorrupt", name, err)
+		}
+		if _, err := Open(dir, Options{}); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("%s: Open returned %v, expected ErrCorrupt", name, err)
+		}
+	}
+}
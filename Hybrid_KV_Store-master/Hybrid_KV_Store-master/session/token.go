@@ -0,0 +1,82 @@
+package session
+
+/*
+	会话令牌：服务端把客户端的因果上下文(vectorclock)编码为不透明的字符串，客户端只需原样回传
+	令牌不在服务端保存，任何节点都可以解码，因此不需要在节点间复制会话
+	格式(base64url): version(1B) | cluster id(4B, 排序后的peers的fnv32a) | 每个peer的计数(uvarint, 按peers排序)
+*/
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+const tokenVersion byte = 1
+
+var (
+	ErrInvalidToken = errors.New("session: invalid token")
+	// the token was issued by a cluster with other peers, the client has to start a new session
+	ErrOtherCluster = errors.New("session: token of another cluster")
+)
+
+type Codec struct {
+	// sorted, so every node encodes the same way whatever the order of its -peers
+	peers     []string
+	clusterID uint32
+}
+
+func NewCodec(peers []string) *Codec {
+	sorted := append([]string{}, peers...)
+	sort.Strings(sorted)
+	h := fnv.New32a()
+	h.Write([]byte(strings.Join(sorted, ",")))
+	return &Codec{
+		peers:     sorted,
+		clusterID: h.Sum32(),
+	}
+}
+
+// Encode returns the token of vc, entries of unknown nodes are dropped
+func (c *Codec) Encode(vc map[string]int32) string {
+	buf := make([]byte, 5+len(c.peers)*binary.MaxVarintLen32)
+	buf[0] = tokenVersion
+	binary.BigEndian.PutUint32(buf[1:5], c.clusterID)
+	n := 5
+	for _, peer := range c.peers {
+		counter := vc[peer]
+		if counter < 0 {
+			counter = 0
+		}
+		n += binary.PutUvarint(buf[n:], uint64(counter))
+	}
+	return base64.RawURLEncoding.EncodeToString(buf[:n])
+}
+
+// Decode returns the vectorclock of token with an entry for every peer
+func (c *Codec) Decode(token string) (map[string]int32, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) < 5 || buf[0] != tokenVersion {
+		return nil, ErrInvalidToken
+	}
+	if binary.BigEndian.Uint32(buf[1:5]) != c.clusterID {
+		return nil, ErrOtherCluster
+	}
+	buf = buf[5:]
+	vc := make(map[string]int32, len(c.peers))
+	for _, peer := range c.peers {
+		counter, n := binary.Uvarint(buf)
+		if n <= 0 || counter > 1<<31-1 {
+			return nil, ErrInvalidToken
+		}
+		vc[peer] = int32(counter)
+		buf = buf[n:]
+	}
+	if len(buf) != 0 {
+		return nil, ErrInvalidToken
+	}
+	return vc, nil
+}
@@ -0,0 +1,217 @@
+package snapshot
+
+/*
+	节点快照的文件格式，用于备份和新副本的初始化
+	"HKVSNAP\n" | version uint32 | 帧...，帧(小端): length uint32 | crc32c(data) uint32 | data
+	data的第一个字节是帧的类型: 'h' Header，'e' Entry，'f' Footer，之后是JSON
+	第一帧是Header(vectorclock、HLC和writeless统计)，然后每个key一个Entry，最后的Footer记录Entry的个数，没有Footer的快照是不完整的
+*/
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/writeless"
+)
+
+const (
+	magic = "HKVSNAP\n"
+	// Version of the format written by Writer
+	Version = 1
+	// frames larger than this are treated as corrupted lengths
+	maxFrameSize = 64 * 1024 * 1024
+
+	kindHeader = 'h'
+	kindEntry  = 'e'
+	kindFooter = 'f'
+)
+
+var (
+	ErrFormat    = errors.New("snapshot: not a snapshot")
+	ErrVersion   = errors.New("snapshot: unsupported version")
+	ErrCorrupt   = errors.New("snapshot: corrupted frame")
+	ErrTruncated = errors.New("snapshot: truncated")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Header describes the node when the snapshot was taken
+type Header struct {
+	Version int
+	// internal address of the node
+	Node string
+	// covers every write in the snapshot and no other
+	VectorClock map[string]int32
+	HLC         int64
+	// unix milliseconds
+	Created int64
+	// local writeless statistics of the node
+	Stats map[string]writeless.Counts `json:",omitempty"`
+}
+
+// Entry is the latest value of a key
+type Entry struct {
+	Key   string
+	Value string
+	// seconds left to live, 0 if the key does not expire
+	TTL uint32 `json:",omitempty"`
+	// version of the value
+	VectorClock map[string]int32 `json:",omitempty"`
+	HLC         int64            `json:",omitempty"`
+}
+
+type footer struct {
+	Entries int64
+}
+
+type Writer struct {
+	w       *bufio.Writer
+	entries int64
+}
+
+// NewWriter writes the magic, the version and the header
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	sw := &Writer{w: bufio.NewWriterSize(w, 64*1024)}
+	header.Version = Version
+	if _, err := sw.w.WriteString(magic); err != nil {
+		return nil, err
+	}
+	var version [4]byte
+	binary.LittleEndian.PutUint32(version[:], Version)
+	if _, err := sw.w.Write(version[:]); err != nil {
+		return nil, err
+	}
+	if err := sw.frame(kindHeader, header); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+func (sw *Writer) frame(kind byte, v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	data := make([]byte, 0, 1+len(payload))
+	data = append(data, kind)
+	data = append(data, payload...)
+	var head [8]byte
+	binary.LittleEndian.PutUint32(head[0:4], uint32(len(data)))
+	binary.LittleEndian.PutUint32(head[4:8], crc32.Checksum(data, crcTable))
+	if _, err := sw.w.Write(head[:]); err != nil {
+		return err
+	}
+	_, err = sw.w.Write(data)
+	return err
+}
+
+func (sw *Writer) WriteEntry(e Entry) error {
+	sw.entries++
+	return sw.frame(kindEntry, e)
+}
+
+// Close writes the footer and flushes, it does not close the underlying writer
+func (sw *Writer) Close() error {
+	if err := sw.frame(kindFooter, footer{Entries: sw.entries}); err != nil {
+		return err
+	}
+	return sw.w.Flush()
+}
+
+type Reader struct {
+	r       *bufio.Reader
+	header  Header
+	entries int64
+	done    bool
+}
+
+// NewReader reads and checks the magic, the version and the header
+func NewReader(r io.Reader) (*Reader, error) {
+	sr := &Reader{r: bufio.NewReaderSize(r, 64*1024)}
+	var start [len(magic) + 4]byte
+	if _, err := io.ReadFull(sr.r, start[:]); err != nil {
+		return nil, ErrFormat
+	}
+	if string(start[:len(magic)]) != magic {
+		return nil, ErrFormat
+	}
+	if version := binary.LittleEndian.Uint32(start[len(magic):]); version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrVersion, version)
+	}
+	kind, payload, err := sr.frame()
+	if err != nil {
+		return nil, err
+	}
+	if kind != kindHeader {
+		return nil, fmt.Errorf("%w: no header", ErrCorrupt)
+	}
+	if err := json.Unmarshal(payload, &sr.header); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	return sr, nil
+}
+
+func (sr *Reader) Header() Header {
+	return sr.header
+}
+
+func (sr *Reader) frame() (kind byte, payload []byte, err error) {
+	var head [8]byte
+	if _, err := io.ReadFull(sr.r, head[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return 0, nil, ErrTruncated
+		}
+		return 0, nil, err
+	}
+	length := binary.LittleEndian.Uint32(head[0:4])
+	if length == 0 || length > maxFrameSize {
+		return 0, nil, fmt.Errorf("%w: length %d", ErrCorrupt, length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(sr.r, data); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return 0, nil, ErrTruncated
+		}
+		return 0, nil, err
+	}
+	if crc32.Checksum(data, crcTable) != binary.LittleEndian.Uint32(head[4:8]) {
+		return 0, nil, fmt.Errorf("%w: checksum", ErrCorrupt)
+	}
+	return data[0], data[1:], nil
+}
+
+// Next returns the next entry, io.EOF after the last one once the footer is checked
+func (sr *Reader) Next() (Entry, error) {
+	var e Entry
+	if sr.done {
+		return e, io.EOF
+	}
+	kind, payload, err := sr.frame()
+	if err != nil {
+		return e, err
+	}
+	switch kind {
+	case kindEntry:
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return e, fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+		sr.entries++
+		return e, nil
+	case kindFooter:
+		var f footer
+		if err := json.Unmarshal(payload, &f); err != nil {
+			return e, fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+		if f.Entries != sr.entries {
+			return e, fmt.Errorf("%w: %d entries, footer says %d", ErrCorrupt, sr.entries, f.Entries)
+		}
+		sr.done = true
+		return e, io.EOF
+	}
+	return e, fmt.Errorf("%w: frame kind %q", ErrCorrupt, kind)
+}
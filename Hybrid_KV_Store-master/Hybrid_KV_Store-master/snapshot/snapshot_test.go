@@ -0,0 +1,170 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/writeless"
+)
+
+func testHeader() Header {
+	return Header{
+		Node:        "a",
+		VectorClock: map[string]int32{"a": 3, "b": 1},
+		HLC:         42,
+		Created:     1000,
+		Stats:       map[string]writeless.Counts{"k0": {Puts: 2, Gets: 5}},
+	}
+}
+
+func testEntries(n int) []Entry {
+	entries := make([]Entry, n)
+	for i := range entries {
+		entries[i] = Entry{
+			Key:         fmt.Sprintf("k%d", i),
+			Value:       fmt.Sprintf("value %d", i),
+			VectorClock: map[string]int32{"a": int32(i + 1)},
+			HLC:         int64(i),
+		}
+	}
+	entries[0].TTL = 60
+	return entries
+}
+
+// write returns a complete snapshot of the entries
+func write(t *testing.T, entries []Entry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	sw, err := NewWriter(&buf, testHeader())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if err := sw.WriteEntry(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// read returns the entries of a snapshot and the first error other than io.EOF
+func read(data []byte) (Header, []Entry, error) {
+	sr, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		return Header{}, nil, err
+	}
+	var entries []Entry
+	for {
+		e, err := sr.Next()
+		if err == io.EOF {
+			return sr.Header(), entries, nil
+		}
+		if err != nil {
+			return sr.Header(), entries, err
+		}
+		entries = append(entries, e)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	entries := testEntries(100)
+	header, got, err := read(write(t, entries))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := testHeader()
+	expected.Version = Version
+	if !reflect.DeepEqual(header, expected) {
+		t.Fatalf("header %+v, expected %+v", header, expected)
+	}
+	if !reflect.DeepEqual(got, entries) {
+		t.Fatalf("read %d entries, not the %d written", len(got), len(entries))
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	_, entries, err := read(write(t, nil))
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("empty snapshot: %d entries, %v", len(entries), err)
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	data := write(t, testEntries(10))
+	// every prefix misses at least the end of the footer
+	for _, n := range []int{len(magic) + 4, len(magic) + 10, len(data) / 2, len(data) - 1} {
+		if _, _, err := read(data[:n]); !errors.Is(err, ErrTruncated) {
+			t.Errorf("%d of %d bytes: %v, expected ErrTruncated", n, len(data), err)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	data := write(t, testEntries(1))
+	if _, _, err := read([]byte("HKV")); !errors.Is(err, ErrFormat) {
+		t.Errorf("short file: %v, expected ErrFormat", err)
+	}
+	other := append([]byte{}, data...)
+	other[0] = 'X'
+	if _, _, err := read(other); !errors.Is(err, ErrFormat) {
+		t.Errorf("bad magic: %v, expected ErrFormat", err)
+	}
+	other = append([]byte{}, data...)
+	binary.LittleEndian.PutUint32(other[len(magic):], Version+1)
+	if _, _, err := read(other); !errors.Is(err, ErrVersion) {
+		t.Errorf("future version: %v, expected ErrVersion", err)
+	}
+}
+
+func TestCorruption(t *testing.T) {
+	data := write(t, testEntries(10))
+	// offsets of the frames
+	var frames []int
+	for offset := len(magic) + 4; offset < len(data); {
+		frames = append(frames, offset)
+		offset += 8 + int(binary.LittleEndian.Uint32(data[offset:]))
+	}
+	if len(frames) != 12 {
+		t.Fatalf("%d frames, expected a header, 10 entries and a footer", len(frames))
+	}
+	for name, offset := range map[string]int{
+		"header data":  frames[0] + 9,
+		"entry data":   frames[5] + 9,
+		"entry crc":    frames[5] + 4,
+		"entry length": frames[5] + 3,
+		"footer data":  frames[11] + 9,
+	} {
+		corrupted := append([]byte{}, data...)
+		corrupted[offset] ^= 0xff
+		if _, _, err := read(corrupted); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("%s: %v, expected ErrCorrupt", name, err)
+		}
+	}
+}
+
+func TestFooterCount(t *testing.T) {
+	var buf bytes.Buffer
+	sw, err := NewWriter(&buf, testHeader())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range testEntries(5) {
+		sw.WriteEntry(e)
+	}
+	// an entry lost between the writer and the footer
+	sw.entries++
+	if err := sw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := read(buf.Bytes()); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("footer of 6 entries after 5: %v, expected ErrCorrupt", err)
+	}
+}
@@ -0,0 +1,179 @@
+package stability
+
+/*
+	因果稳定性: 每个节点的vectorclock只覆盖它已经应用的写，所有节点的vectorclock逐项取最小值得到稳定前沿(frontier)
+	被frontier覆盖的写已经被每个副本应用，依赖"所有副本都已经看到"的状态(WAL的段、删除标记、旧版本)可以释放
+	节点之间定期gossip自己的vectorclock，收到的lattice也带有发送者的vectorclock
+*/
+
+import (
+	"sync"
+	"time"
+)
+
+// NodeClock is the latest vectorclock known of a node
+type NodeClock struct {
+	Node        string
+	VectorClock map[string]int32
+	// time of the last observation, zero if the node has not been heard from
+	Updated time.Time
+}
+
+type Tracker struct {
+	mu    sync.Mutex
+	nodes []string
+	// latest vectorclock of every node
+	clocks  map[string]map[string]int32
+	updated map[string]time.Time
+	// nil until every node has been heard from
+	frontier map[string]int32
+	// subscribers are called by notifyLoop, in order, with the latest frontier
+	subscribers map[int]func(frontier map[string]int32)
+	nextID      int
+	notify      chan struct{}
+}
+
+// NewTracker tracks the vectorclocks of nodes, the ids in the vectorclocks
+func NewTracker(nodes []string) *Tracker {
+	t := &Tracker{
+		nodes:       nodes,
+		clocks:      make(map[string]map[string]int32),
+		updated:     make(map[string]time.Time),
+		subscribers: make(map[int]func(map[string]int32)),
+		notify:      make(chan struct{}, 1),
+	}
+	go t.notifyLoop()
+	return t
+}
+
+// Observe merges a vectorclock of node, the subscribers are notified if the frontier advances
+func (t *Tracker) Observe(node string, vc map[string]int32) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	clock, ok := t.clocks[node]
+	if !ok {
+		clock = make(map[string]int32, len(vc))
+		t.clocks[node] = clock
+	}
+	for id, counter := range vc {
+		if counter > clock[id] {
+			clock[id] = counter
+		}
+	}
+	t.updated[node] = time.Now()
+	frontier := t.computeLocked()
+	if frontier == nil || equal(frontier, t.frontier) {
+		return
+	}
+	t.frontier = frontier
+	select {
+	case t.notify <- struct{}{}:
+	default:
+	}
+}
+
+// computeLocked returns the pointwise minimum of the clocks of all nodes, nil if a node has not been heard from
+func (t *Tracker) computeLocked() map[string]int32 {
+	frontier := make(map[string]int32, len(t.nodes))
+	for _, id := range t.nodes {
+		frontier[id] = -1
+	}
+	for _, node := range t.nodes {
+		clock, ok := t.clocks[node]
+		if !ok {
+			return nil
+		}
+		for _, id := range t.nodes {
+			if frontier[id] < 0 || clock[id] < frontier[id] {
+				frontier[id] = clock[id]
+			}
+		}
+	}
+	return frontier
+}
+
+// Frontier returns the stable vectorclock, ok is false until every node has been heard from
+func (t *Tracker) Frontier() (frontier map[string]int32, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.frontier == nil {
+		return nil, false
+	}
+	return copyClock(t.frontier), true
+}
+
+// Clocks returns the latest vectorclock of every node, in the order of the nodes
+func (t *Tracker) Clocks() []NodeClock {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	clocks := make([]NodeClock, 0, len(t.nodes))
+	for _, node := range t.nodes {
+		clocks = append(clocks, NodeClock{
+			Node:        node,
+			VectorClock: copyClock(t.clocks[node]),
+			Updated:     t.updated[node],
+		})
+	}
+	return clocks
+}
+
+// Subscribe calls fn with the frontier each time it advances, and once now if it is known.
+// fn runs on the goroutine of the tracker and should not block for long. cancel removes the subscription
+func (t *Tracker) Subscribe(fn func(frontier map[string]int32)) (cancel func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := t.nextID
+	t.nextID++
+	t.subscribers[id] = fn
+	if t.frontier != nil {
+		select {
+		case t.notify <- struct{}{}:
+		default:
+		}
+	}
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.subscribers, id)
+	}
+}
+
+func (t *Tracker) notifyLoop() {
+	for range t.notify {
+		t.mu.Lock()
+		frontier := t.frontier
+		subscribers := make([]func(map[string]int32), 0, len(t.subscribers))
+		for id := 0; id < t.nextID; id++ {
+			if fn, ok := t.subscribers[id]; ok {
+				subscribers = append(subscribers, fn)
+			}
+		}
+		t.mu.Unlock()
+		for _, fn := range subscribers {
+			fn(copyClock(frontier))
+		}
+	}
+}
+
+func copyClock(vc map[string]int32) map[string]int32 {
+	if vc == nil {
+		return nil
+	}
+	clock := make(map[string]int32, len(vc))
+	for id, counter := range vc {
+		clock[id] = counter
+	}
+	return clock
+}
+
+func equal(a map[string]int32, b map[string]int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, counter := range a {
+		if c, ok := b[id]; !ok || c != counter {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,300 @@
+package store
+
+/*
+	多版本历史(MVCC): 每个key在内存中保留最近的若干个版本，或者保留窗口内的版本
+	每个版本带有写的vectorclock和HLC时间戳，只读事务按快照vectorclock读取，GetAt按时间戳读取
+	版本按应用的顺序保存；节点重启之前写入的值没有历史，视为vectorclock和时间戳为0的版本
+	历史占用的内存有上限(maxBytes): 超过时最久没有写过的key先只保留最新版本，再丢弃整个历史，之后同样视为没有历史
+*/
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/hlc"
+)
+
+const (
+	// DefaultMaxVersions is the number of versions kept per key if SetRetention is not called
+	DefaultMaxVersions = 8
+	// DefaultMaxHistoryBytes is the memory of the history if SetRetention is not called
+	DefaultMaxHistoryBytes = 64 * 1024 * 1024
+	// estimated memory of a version besides its value and vectorclock
+	versionOverhead = 64
+)
+
+// ErrSnapshotTooOld is returned when the versions of a key visible at a snapshot or a timestamp have been discarded
+var ErrSnapshotTooOld = errors.New("store: the history does not reach back to the snapshot")
+
+// Version is a write of a key, VectorClock and Timestamp (HLC) are the ones of the write, nil and 0 before the restart
+type Version struct {
+	Value       []byte
+	Deleted     bool
+	VectorClock map[string]int32
+	Timestamp   int64
+}
+
+type keyHistory struct {
+	// oldest first
+	versions []Version
+	// older versions have been discarded
+	trimmed bool
+	bytes   int64
+	// element of the key in history.order
+	elem *list.Element
+}
+
+func versionBytes(v Version) int64 {
+	return int64(len(v.Value) + 16*len(v.VectorClock) + versionOverhead)
+}
+
+type history struct {
+	mu sync.RWMutex
+	// 0 means no limit
+	maxVersions int
+	// versions replaced longer than retention ago are discarded by GC, 0 keeps them
+	retention time.Duration
+	// 0 means no limit
+	maxBytes int64
+	bytes    int64
+	keys     map[string]*keyHistory
+	// keys by their last write, the front is the oldest
+	order *list.List
+	init  bool
+}
+
+// setVersions replaces the versions of kh and updates the memory, requires mu
+func (h *history) setVersions(kh *keyHistory, versions []Version) {
+	h.bytes -= kh.bytes
+	kh.versions = versions
+	kh.bytes = 0
+	for _, v := range versions {
+		kh.bytes += versionBytes(v)
+	}
+	h.bytes += kh.bytes
+}
+
+// drop discards the history of key, requires mu
+func (h *history) drop(key string) {
+	kh, ok := h.keys[key]
+	if !ok {
+		return
+	}
+	h.bytes -= kh.bytes + int64(len(key))
+	h.order.Remove(kh.elem)
+	delete(h.keys, key)
+}
+
+// shrink keeps the memory under maxBytes: the keys written longest ago first keep only their latest version,
+// then lose their history. The key written last is kept, requires mu
+func (h *history) shrink() {
+	if h.maxBytes <= 0 || h.order == nil {
+		return
+	}
+	for e := h.order.Front(); e != nil && e != h.order.Back() && h.bytes > h.maxBytes; e = e.Next() {
+		kh := h.keys[e.Value.(string)]
+		if len(kh.versions) > 1 {
+			h.setVersions(kh, []Version{kh.versions[len(kh.versions)-1]})
+			kh.trimmed = true
+		}
+	}
+	for h.bytes > h.maxBytes && h.order.Len() > 1 {
+		h.drop(h.order.Front().Value.(string))
+	}
+}
+
+// SetRetention keeps at most maxVersions versions per key (0 means no limit), at most maxBytes of versions
+// in memory (0 means no limit) and the versions which were still the latest retention ago (0 means no time limit);
+// the latest version is always kept unless the memory limit is reached
+func (p *Store) SetRetention(maxVersions int, maxBytes int64, retention time.Duration) {
+	p.history.mu.Lock()
+	defer p.history.mu.Unlock()
+	if maxVersions < 0 {
+		maxVersions = 0
+	}
+	p.history.maxVersions = maxVersions
+	p.history.maxBytes = maxBytes
+	p.history.retention = retention
+	p.history.init = true
+	p.history.shrink()
+}
+
+// DropHistory discards the versions of key, it is called when the key is gone from the store without a write
+func (p *Store) DropHistory(key string) {
+	p.history.mu.Lock()
+	defer p.history.mu.Unlock()
+	p.history.drop(key)
+}
+
+// HistoryBytes returns the estimated memory of the versions and the number of keys with versions
+func (p *Store) HistoryBytes() (bytes int64, keys int) {
+	p.history.mu.RLock()
+	defer p.history.mu.RUnlock()
+	return p.history.bytes, len(p.history.keys)
+}
+
+// AddVersion records a write of key at vc and ts, it must be called before the write is applied to the store
+func (p *Store) AddVersion(key string, value []byte, deleted bool, vc map[string]int32, ts int64) {
+	h := &p.history
+	// the value written before the restart is read without the history lock, the store takes its own lock
+	h.mu.RLock()
+	_, known := h.keys[key]
+	h.mu.RUnlock()
+	var before []Version
+	if !known && p.Has(key) {
+		before = []Version{{Value: p.Get(key)}}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.keys == nil {
+		h.keys = make(map[string]*keyHistory)
+		h.order = list.New()
+	}
+	if !h.init {
+		h.maxVersions = DefaultMaxVersions
+		h.maxBytes = DefaultMaxHistoryBytes
+		h.init = true
+	}
+	kh, ok := h.keys[key]
+	versions := []Version{}
+	if ok {
+		versions = kh.versions
+		h.order.MoveToBack(kh.elem)
+	} else {
+		kh = &keyHistory{elem: h.order.PushBack(key)}
+		h.keys[key] = kh
+		h.bytes += int64(len(key))
+		// written before the restart of this node (or before its history was dropped), its vectorclock is unknown
+		versions = append(versions, before...)
+	}
+	versions = append(versions, Version{Value: value, Deleted: deleted, VectorClock: vc, Timestamp: ts})
+	if h.maxVersions > 0 && len(versions) > h.maxVersions {
+		versions = append([]Version{}, versions[len(versions)-h.maxVersions:]...)
+		kh.trimmed = true
+	}
+	h.setVersions(kh, versions)
+	h.shrink()
+}
+
+// LatestVersion returns the last write of key, false if the key has no history
+func (p *Store) LatestVersion(key string) (Version, bool) {
+	h := &p.history
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	kh, ok := h.keys[key]
+	if !ok || len(kh.versions) == 0 {
+		return Version{}, false
+	}
+	return kh.versions[len(kh.versions)-1], true
+}
+
+// VersionAt returns the value of key at snapshot, the newest version whose vectorclock is covered by snapshot.
+// found is false if the key does not exist at snapshot
+func (p *Store) VersionAt(key string, snapshot map[string]int32) (value []byte, found bool, err error) {
+	v, found, err := p.versionWhere(key, func(v Version) bool {
+		return covers(snapshot, v.VectorClock)
+	})
+	return v.Value, found, err
+}
+
+// VersionAtTime returns the newest version of key whose timestamp is not after ts, found is false if the key did not exist at ts
+func (p *Store) VersionAtTime(key string, ts int64) (v Version, found bool, err error) {
+	return p.versionWhere(key, func(v Version) bool {
+		return v.Timestamp <= ts
+	})
+}
+
+// versionWhere returns the newest version of key which is visible
+func (p *Store) versionWhere(key string, visible func(Version) bool) (Version, bool, error) {
+	h := &p.history
+	h.mu.RLock()
+	kh, ok := h.keys[key]
+	if !ok {
+		h.mu.RUnlock()
+		// never written since the restart, the current value is the only version
+		if p.Has(key) {
+			return Version{Value: p.Get(key)}, true, nil
+		}
+		return Version{}, false, nil
+	}
+	for i := len(kh.versions) - 1; i >= 0; i-- {
+		v := kh.versions[i]
+		if !visible(v) {
+			continue
+		}
+		latest := i == len(kh.versions)-1
+		h.mu.RUnlock()
+		if v.Deleted {
+			return v, false, nil
+		}
+		// the latest version may have expired
+		if latest && !p.Has(key) {
+			return v, false, nil
+		}
+		return v, true, nil
+	}
+	trimmed := kh.trimmed
+	h.mu.RUnlock()
+	if trimmed {
+		return Version{}, false, ErrSnapshotTooOld
+	}
+	// the key was created later
+	return Version{}, false, nil
+}
+
+// History returns the versions of key, oldest first; trimmed is true if older versions have been discarded
+func (p *Store) History(key string) (versions []Version, trimmed bool) {
+	h := &p.history
+	h.mu.RLock()
+	kh, ok := h.keys[key]
+	if ok {
+		versions, trimmed = append([]Version{}, kh.versions...), kh.trimmed
+	}
+	h.mu.RUnlock()
+	if !ok && p.Has(key) {
+		return []Version{{Value: p.Get(key)}}, false
+	}
+	return versions, trimmed
+}
+
+// GC discards the versions which were replaced before now-retention, and the keys whose latest version is
+// a delete before it. Returns the number of discarded versions
+func (p *Store) GC(now time.Time) int {
+	h := &p.history
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.retention <= 0 {
+		return 0
+	}
+	cutoff := hlc.FromTime(now.Add(-h.retention))
+	discarded := 0
+	for key, kh := range h.keys {
+		// versions[i] is needed while versions[i+1] is inside the window
+		keep := 0
+		for keep < len(kh.versions)-1 && kh.versions[keep+1].Timestamp <= cutoff {
+			keep++
+		}
+		if keep > 0 {
+			h.setVersions(kh, append([]Version{}, kh.versions[keep:]...))
+			kh.trimmed = true
+			discarded += keep
+		}
+		if latest := kh.versions[len(kh.versions)-1]; latest.Deleted && latest.Timestamp <= cutoff {
+			h.drop(key)
+			discarded++
+		}
+	}
+	return discarded
+}
+
+// covers reports whether every entry of vc is not greater than the one of snapshot, missing entries are 0
+func covers(snapshot map[string]int32, vc map[string]int32) bool {
+	for id, counter := range vc {
+		if counter > snapshot[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func openTiered(t *testing.T) *Store {
+	t.Helper()
+	p := &Store{}
+	// second-hit keeps the keys written once out of the cache, their reads go to the engine
+	if err := p.Open(t.TempDir(), Options{Engine: EngineTiered, CacheBytes: 1024 * 1024, Admission: AdmitSecondHit}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		p.engine.Close()
+		p.meta.Close()
+	})
+	return p
+}
+
+func TestHistoryVersions(t *testing.T) {
+	p := openTiered(t)
+	// written before the restart, no history
+	p.Put("k", "v0")
+	p.AddVersion("k", []byte("v1"), false, map[string]int32{"a": 1}, 10)
+	p.Put("k", "v1")
+	p.AddVersion("k", []byte("v2"), false, map[string]int32{"a": 2}, 20)
+	p.Put("k", "v2")
+
+	versions, trimmed := p.History("k")
+	if trimmed || len(versions) != 3 {
+		t.Fatalf("%d versions, trimmed %v, expected 3", len(versions), trimmed)
+	}
+	for i, value := range []string{"v0", "v1", "v2"} {
+		if string(versions[i].Value) != value {
+			t.Fatalf("version %d is %q, expected %q", i, versions[i].Value, value)
+		}
+	}
+	if versions[0].VectorClock != nil {
+		t.Fatalf("the version before the restart has the clock %v", versions[0].VectorClock)
+	}
+	for snapshot, expected := range map[int32]string{0: "v0", 1: "v1", 5: "v2"} {
+		value, found, err := p.VersionAt("k", map[string]int32{"a": snapshot})
+		if err != nil || !found || string(value) != expected {
+			t.Fatalf("at %d: %q %v %v, expected %q", snapshot, value, found, err, expected)
+		}
+	}
+	if v, _, _ := p.VersionAtTime("k", 15); string(v.Value) != "v1" {
+		t.Fatalf("at time 15: %q, expected v1", v.Value)
+	}
+
+	// a key written only since the restart did not exist before its first version
+	p.AddVersion("new", []byte("x"), false, map[string]int32{"a": 3}, 30)
+	p.Put("new", "x")
+	if _, found, err := p.VersionAt("new", map[string]int32{"a": 2}); found || err != nil {
+		t.Fatalf("new key found before its write: %v %v", found, err)
+	}
+}
+
+func TestHistoryMaxVersions(t *testing.T) {
+	p := openTiered(t)
+	p.SetRetention(2, 0, 0)
+	for i := int32(1); i <= 5; i++ {
+		p.AddVersion("k", []byte(fmt.Sprint(i)), false, map[string]int32{"a": i}, int64(i))
+		p.Put("k", fmt.Sprint(i))
+	}
+	versions, trimmed := p.History("k")
+	if !trimmed || len(versions) != 2 || string(versions[0].Value) != "4" {
+		t.Fatalf("versions %v, trimmed %v, expected 4 and 5", versions, trimmed)
+	}
+	if _, _, err := p.VersionAt("k", map[string]int32{"a": 2}); err != ErrSnapshotTooOld {
+		t.Fatalf("read before the kept versions: %v, expected ErrSnapshotTooOld", err)
+	}
+}
+
+func TestHistoryMaxBytes(t *testing.T) {
+	p := openTiered(t)
+	const maxBytes = 10000
+	p.SetRetention(0, maxBytes, 0)
+	value := make([]byte, 100)
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("k%03d", i%200)
+		p.AddVersion(key, value, false, map[string]int32{"a": int32(i)}, int64(i))
+		p.Put(key, string(value))
+		if bytes, _ := p.HistoryBytes(); bytes > maxBytes {
+			t.Fatalf("%d bytes of history after %d writes, limit %d", bytes, i+1, maxBytes)
+		}
+	}
+	// the key written last keeps its versions
+	if versions, _ := p.History("k199"); len(versions) == 0 || versions[len(versions)-1].VectorClock["a"] != 999 {
+		t.Fatalf("the last write lost its history: %v", versions)
+	}
+}
+
+func TestPurgeDropsHistory(t *testing.T) {
+	p := openTiered(t)
+	p.AddVersion("k", []byte("v"), false, map[string]int32{"a": 1}, 1)
+	p.PutTTL("k", "v", 1)
+	time.Sleep(2100 * time.Millisecond)
+	if value := p.Get("k"); value != nil {
+		t.Fatalf("expired key read as %q", value)
+	}
+	if _, ok := p.LatestVersion("k"); ok {
+		t.Fatal("the expired key kept its history")
+	}
+	if bytes, keys := p.HistoryBytes(); bytes != 0 || keys != 0 {
+		t.Fatalf("%d bytes of history for %d keys left", bytes, keys)
+	}
+}
+
+// expired keys are purged by reads while other keys get their first version, both sides use the lock of the store
+// and the lock of the history: holding one while taking the other deadlocks
+func TestPurgeConcurrentWrites(t *testing.T) {
+	p := openTiered(t)
+	const keys = 5000
+	for i := 0; i < keys; i++ {
+		p.PutTTL(fmt.Sprintf("expiring%d", i), "v", 1)
+		p.Put(fmt.Sprintf("old%d", i), "v")
+	}
+	time.Sleep(2100 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for w := 0; w < 4; w++ {
+			wg.Add(2)
+			go func(w int) {
+				defer wg.Done()
+				for i := w; i < keys; i += 4 {
+					p.Get(fmt.Sprintf("expiring%d", i))
+				}
+			}(w)
+			go func(w int) {
+				defer wg.Done()
+				for i := w; i < keys; i += 4 {
+					key := fmt.Sprintf("old%d", i)
+					p.AddVersion(key, []byte("w"), false, map[string]int32{"a": int32(i)}, int64(i))
+					p.Put(key, "w")
+					p.History(key)
+				}
+			}(w)
+		}
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("deadlock between purge and AddVersion")
+	}
+	for i := 0; i < keys; i++ {
+		if versions, _ := p.History(fmt.Sprintf("old%d", i)); len(versions) != 2 || string(versions[0].Value) != "v" {
+			t.Fatalf("old%d: versions %v, expected the value before the restart and the write", i, versions)
+		}
+	}
+}
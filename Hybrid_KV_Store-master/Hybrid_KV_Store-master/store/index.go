@@ -0,0 +1,159 @@
+package store
+
+/*
+	有序索引: freecache不能按顺序遍历，key另外保存在goleveldb的memdb(跳表)中，用来做范围扫描和前缀扫描
+	值在freecache中(分层存储时也在leveldb引擎中，启动时从引擎重建索引)；过期的key在扫描时跳过并从索引中删除
+	memory引擎的key被freecache淘汰或者过期时索引不会收到通知: 每次Put顺序检查索引中的几个key，删除已经不存在的，
+	memdb删除key不会释放空间，删除的key超过剩余的key时重建索引
+*/
+
+import (
+	"sync/atomic"
+
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	lutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// index keys checked on every put of the memory engine
+	indexPrunePerPut = 4
+	// deleted keys before the index may be rebuilt
+	indexMinRebuild = 4096
+)
+
+func newIndex() *memdb.DB {
+	return memdb.New(comparer.DefaultComparer, 0)
+}
+
+func (p *Store) indexPut(key string) {
+	p.indexMu.RLock()
+	defer p.indexMu.RUnlock()
+	// memdb appends the key on every Put
+	if !p.index.Contains([]byte(key)) {
+		p.index.Put([]byte(key), nil)
+	}
+}
+
+func (p *Store) indexDelete(key string) {
+	p.indexMu.RLock()
+	defer p.indexMu.RUnlock()
+	if p.index.Contains([]byte(key)) {
+		p.index.Delete([]byte(key))
+		atomic.AddInt64(&p.indexDeleted, 1)
+	}
+}
+
+func (p *Store) indexLen() int {
+	p.indexMu.RLock()
+	defer p.indexMu.RUnlock()
+	return p.index.Len()
+}
+
+// indexReset empties the index, the caller blocks the writes of the store
+func (p *Store) indexReset() {
+	p.indexMu.Lock()
+	defer p.indexMu.Unlock()
+	p.index = newIndex()
+	atomic.StoreInt64(&p.indexDeleted, 0)
+}
+
+// pruneIndex checks the next n keys of the index after the ones checked by the last call, starting over at the end,
+// and deletes the keys which no longer exist. Only one caller sweeps at a time, the others return at once
+func (p *Store) pruneIndex(n int) {
+	if !p.pruneMu.TryLock() {
+		return
+	}
+	defer p.pruneMu.Unlock()
+	stale := make([]string, 0)
+	checked := 0
+	p.indexMu.RLock()
+	for checked < n {
+		// keys appended after the cursor (increasing keys) would keep it from ever starting over
+		wrapped := p.pruneCursor == nil
+		iter := p.index.NewIterator(&lutil.Range{Start: p.pruneCursor})
+		p.pruneCursor = nil
+		for checked < n && iter.Next() {
+			key := string(iter.Key())
+			if !p.Has(key) {
+				stale = append(stale, key)
+			}
+			checked++
+			// the smallest key after key
+			p.pruneCursor = append([]byte(key), 0)
+		}
+		iter.Release()
+		if wrapped && checked < n {
+			// fewer than n keys in the index
+			break
+		}
+	}
+	p.indexMu.RUnlock()
+	for _, key := range stale {
+		if !p.Has(key) {
+			p.indexDelete(key)
+		}
+	}
+	if deleted := atomic.LoadInt64(&p.indexDeleted); deleted > indexMinRebuild && deleted > int64(p.indexLen()) {
+		p.rebuildIndex()
+	}
+}
+
+// rebuildIndex copies the keys into a new index, the space of the deleted keys is freed with the old one
+func (p *Store) rebuildIndex() {
+	p.indexMu.Lock()
+	defer p.indexMu.Unlock()
+	index := newIndex()
+	iter := p.index.NewIterator(nil)
+	for iter.Next() {
+		index.Put(iter.Key(), nil)
+	}
+	iter.Release()
+	p.index = index
+	atomic.StoreInt64(&p.indexDeleted, 0)
+}
+
+// Scan returns up to limit existing keys in [start, end) in order, end "" means no upper bound.
+// next is the key to start the following page from, "" if there are no more keys
+func (p *Store) Scan(start string, end string, limit int) (keys []string, next string) {
+	r := &lutil.Range{Start: []byte(start)}
+	if end != "" {
+		r.Limit = []byte(end)
+	}
+	return p.scanRange(r, limit)
+}
+
+// ScanPrefix is Scan of the keys with prefix, starting at start (at least prefix)
+func (p *Store) ScanPrefix(prefix string, start string, limit int) (keys []string, next string) {
+	r := lutil.BytesPrefix([]byte(prefix))
+	if start > prefix {
+		r.Start = []byte(start)
+	}
+	return p.scanRange(r, limit)
+}
+
+func (p *Store) scanRange(r *lutil.Range, limit int) (keys []string, next string) {
+	expired := make([]string, 0)
+	p.indexMu.RLock()
+	iter := p.index.NewIterator(r)
+	for iter.Next() {
+		key := string(iter.Key())
+		if !p.Has(key) {
+			expired = append(expired, key)
+			continue
+		}
+		if limit > 0 && len(keys) == limit {
+			next = key
+			break
+		}
+		keys = append(keys, key)
+	}
+	iter.Release()
+	p.indexMu.RUnlock()
+	for _, key := range expired {
+		if !p.Has(key) {
+			p.indexDelete(key)
+		}
+	}
+	return keys, next
+}
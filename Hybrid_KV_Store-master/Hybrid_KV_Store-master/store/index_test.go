@@ -0,0 +1,34 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// keys expired by freecache leave the index of the memory engine through the puts of other keys
+func TestPruneIndex(t *testing.T) {
+	p := &Store{}
+	if err := p.Open(t.TempDir(), Options{Engine: EngineMemory, CacheBytes: 16 * 1024 * 1024}); err != nil {
+		t.Fatal(err)
+	}
+	defer p.meta.Close()
+	const keys = 10000
+	for i := 0; i < keys; i++ {
+		p.PutTTL(fmt.Sprintf("expiring%05d", i), "v", 1)
+	}
+	time.Sleep(2100 * time.Millisecond)
+	for i := 0; i < keys; i++ {
+		p.Put(fmt.Sprintf("live%05d", i), "v")
+	}
+	if n := p.indexLen(); n > keys+keys/10 {
+		t.Fatalf("%d keys in the index, %d of them live", n, keys)
+	}
+	if scanned, _ := p.Scan("", "", 0); len(scanned) != keys {
+		t.Fatalf("scanned %d keys, expected %d", len(scanned), keys)
+	}
+	// the index was rebuilt without the deleted keys
+	if deleted := p.indexDeleted; deleted > indexMinRebuild {
+		t.Fatalf("%d deleted keys left in the index", deleted)
+	}
+}
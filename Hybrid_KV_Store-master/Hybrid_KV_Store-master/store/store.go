@@ -1,45 +1,106 @@
 package store
 
 import (
+	"fmt"
+	"path/filepath"
 	"runtime/debug"
+	"sync"
+	"sync/atomic"
 
 	"github.com/JasonLou99/Hybrid_KV_Store/util"
 
 	"github.com/coocood/freecache"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
 )
 
 type Store struct {
-	// path string
+	opts Options
+	path string
 	// db *leveldb.DB
+	// cache of the engine, or the only copy of the keys with EngineMemory
 	db *freecache.Cache
+	// persistent engine under the cache, nil with EngineMemory, see tiered.go
+	engine     *leveldb.DB
+	enginePath string
+	// writes to the engine and cache fills after a miss, see tieredGet
+	mu         sync.RWMutex
+	doorkeeper *doorkeeper
+	counters   cacheCounters
+	// durable metadata of the node (writeless statistics...), survives restarts
+	meta *leveldb.DB
+	// recent versions of every key, see history.go
+	history history
+	// ordered keys for scans, see index.go. indexMu guards the pointer, the index is replaced when it is rebuilt
+	index        *memdb.DB
+	indexMu      sync.RWMutex
+	indexDeleted int64
+	// pruneCursor is the key the next pruneIndex starts from
+	pruneMu     sync.Mutex
+	pruneCursor []byte
 }
 
-func (p *Store) Init(path string) {
+// Init opens a tiered store with the default options
+func (p *Store) Init(path string) error {
+	return p.Open(path, Options{})
+}
 
-	// LevelDB
-	// var err error
-	// //数据存储路径和一些初始文件
-	// p.db, err = leveldb.OpenFile(path, nil)
-	// if err != nil {
-	// 	util.EPrintf("Open db failed, err: %s", err)
-	// }
+// Open returns an error if the options are invalid or the engine cannot be opened, the store is not usable then
+func (p *Store) Open(path string, opts Options) error {
+	var err error
+	p.path = path
+	p.opts, err = opts.withDefaults()
+	if err != nil {
+		return err
+	}
+	p.meta, err = leveldb.OpenFile(filepath.Join(path, "meta"), nil)
+	if err != nil {
+		util.EPrintf("Open meta db failed, err: %s", err)
+	}
 
 	// FreeCache
-	cacheSize := 100 * 1024 * 1024
-	p.db = freecache.NewCache(cacheSize)
+	p.db = freecache.NewCache(p.opts.CacheBytes)
+	p.index = newIndex()
+	if p.opts.Admission == AdmitSecondHit {
+		p.doorkeeper = newDoorkeeper(p.opts.CacheBytes)
+	}
 	debug.SetGCPercent(20)
 
+	// LevelDB
+	if p.opts.Engine == EngineTiered {
+		p.enginePath = filepath.Join(path, "data")
+		if err := p.openEngine(p.enginePath); err != nil {
+			return fmt.Errorf("open data db: %w", err)
+		}
+		util.IPrintf("Store: %d keys in %s, cache %d bytes, admission %s", p.indexLen(), p.enginePath, p.opts.CacheBytes, p.opts.Admission)
+	}
+	return nil
 }
 
 func (p *Store) Put(key string, value string) {
+	p.PutTTL(key, value, 0)
+}
+
+// PutTTL writes the value and its time to live at once, seconds 0 means the key does not expire
+func (p *Store) PutTTL(key string, value string, seconds int) {
 	//leveldb
 	/* 	err := p.db.Put([]byte(key), []byte(value), nil)
 	   	if err != nil {
 	   		util.EPrintf("Put key %s value %s failed, err: %s", key, value, err)
 	   	} */
 
+	if p.engine != nil {
+		p.tieredPut(key, value, seconds)
+		return
+	}
+
 	//freecache
-	p.db.Set([]byte(key), []byte(value), 60)
+	if seconds <= 0 {
+		seconds = 60
+	}
+	p.db.Set([]byte(key), []byte(value), seconds)
+	p.indexPut(key)
+	p.pruneIndex(indexPrunePerPut)
 }
 
 func (p *Store) Get(key string) []byte {
@@ -50,11 +111,97 @@ func (p *Store) Get(key string) []byte {
 	   	}
 	   	return value */
 
+	if p.engine != nil {
+		return p.tieredGet(key)
+	}
+
 	//freecache
 	value, err := p.db.Get([]byte(key))
 	if err != nil {
+		atomic.AddInt64(&p.counters.misses, 1)
 		util.EPrintf("Get key %s failed, err: %s", key, err)
 		return nil
 	}
+	atomic.AddInt64(&p.counters.hits, 1)
+	return value
+}
+
+// Delete returns false if the key does not exist
+func (p *Store) Delete(key string) bool {
+	if p.engine != nil {
+		return p.tieredDelete(key)
+	}
+	p.indexDelete(key)
+	return p.db.Del([]byte(key))
+}
+
+// Expire resets the time to live of key, returns false if the key does not exist
+func (p *Store) Expire(key string, seconds int) bool {
+	if p.engine != nil {
+		return p.tieredExpire(key, seconds)
+	}
+	return p.db.Touch([]byte(key), seconds) == nil
+}
+
+// Has checks the key without counting a lookup, expired keys do not exist
+func (p *Store) Has(key string) bool {
+	if p.engine != nil {
+		_, ok := p.tieredTTL(key)
+		return ok
+	}
+	_, err := p.db.TTL([]byte(key))
+	return err == nil
+}
+
+// TTL returns the seconds left to live of key, 0 if it does not expire
+func (p *Store) TTL(key string) (seconds uint32, ok bool) {
+	if p.engine != nil {
+		return p.tieredTTL(key)
+	}
+	seconds, err := p.db.TTL([]byte(key))
+	return seconds, err == nil
+}
+
+// Len returns the number of keys, with the engine keys which expired but have not been scanned yet are counted
+func (p *Store) Len() int64 {
+	if p.engine != nil {
+		return int64(p.indexLen())
+	}
+	return p.db.EntryCount()
+}
+
+// Durable reports whether the keys survive a restart without the write-ahead log
+func (p *Store) Durable() bool {
+	return p.engine != nil
+}
+
+// cached checks whether key is in the cache
+func (p *Store) cached(key string) bool {
+	_, err := p.db.TTL([]byte(key))
+	return err == nil
+}
+
+// Metadata，持久化在leveldb中
+func (p *Store) PutMeta(key string, value []byte) {
+	if p.meta == nil {
+		return
+	}
+	err := p.meta.Put([]byte(key), value, nil)
+	if err != nil {
+		util.EPrintf("Put meta %s failed, err: %s", key, err)
+	}
+}
+
+func (p *Store) GetMeta(key string) []byte {
+	if p.meta == nil {
+		return nil
+	}
+	value, err := p.meta.Get([]byte(key), nil)
+	if err != nil {
+		if err != leveldb.ErrNotFound {
+			util.EPrintf("Get meta %s failed, err: %s", key, err)
+		}
+		return nil
+	}
 	return value
 }
@@ -0,0 +1,370 @@
+package store
+
+/*
+	分层存储: freecache作为缓存，下面是持久化的leveldb引擎(<dbPath>/data)
+	写同时写入引擎和缓存(write-through)，缓存未命中时从引擎读取并按准入策略填充缓存(read-through)
+	freecache满了会淘汰key，被淘汰的key仍然可以从引擎读到；引擎中的值带有过期时间: expireAt(unix秒，大端8字节，0表示不过期) | value
+	准入策略: always 每次未命中都放入缓存；second-hit 一个key第二次被访问时才放入缓存，只访问一次的key不会挤掉缓存中的热点key
+*/
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const (
+	// EngineTiered keeps every key in leveldb under the cache
+	EngineTiered = "tiered"
+	// EngineMemory keeps the keys only in freecache, they are lost on eviction and on restart
+	EngineMemory = "memory"
+
+	AdmitAlways    = "always"
+	AdmitSecondHit = "second-hit"
+
+	// DefaultCacheBytes is the size of the cache if Options.CacheBytes is 0
+	DefaultCacheBytes = 100 * 1024 * 1024
+)
+
+// Options of the store, the zero value is a tiered store with a 100MB cache admitting every key
+type Options struct {
+	Engine     string
+	CacheBytes int
+	Admission  string
+}
+
+func (o Options) withDefaults() (Options, error) {
+	if o.Engine == "" {
+		o.Engine = EngineTiered
+	}
+	if o.CacheBytes <= 0 {
+		o.CacheBytes = DefaultCacheBytes
+	}
+	if o.Admission == "" {
+		o.Admission = AdmitAlways
+	}
+	if o.Engine != EngineTiered && o.Engine != EngineMemory {
+		return o, fmt.Errorf("unknown store engine %q, tiered or memory", o.Engine)
+	}
+	if o.Admission != AdmitAlways && o.Admission != AdmitSecondHit {
+		return o, fmt.Errorf("unknown cache admission %q, always or second-hit", o.Admission)
+	}
+	return o, nil
+}
+
+// CacheStats counts the lookups of Get, Has and TTL are not counted
+type CacheStats struct {
+	Engine     string
+	Admission  string
+	CacheBytes int
+	// keys in the cache
+	Entries int64
+	Hits    int64
+	// lookups not answered by the cache, found in the engine or not
+	Misses     int64
+	EngineHits int64
+	// read misses and writes which were (not) put into the cache
+	Admitted int64
+	Rejected int64
+	// keys evicted by freecache to make room, and expired keys removed by it
+	Evictions int64
+	Expired   int64
+}
+
+// HitRatio is Hits / (Hits + Misses), 0 before the first lookup
+func (s CacheStats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+type cacheCounters struct {
+	hits       int64
+	misses     int64
+	engineHits int64
+	admitted   int64
+	rejected   int64
+}
+
+// CacheStats returns the counters of the cache since the start
+func (p *Store) CacheStats() CacheStats {
+	return CacheStats{
+		Engine:     p.opts.Engine,
+		Admission:  p.opts.Admission,
+		CacheBytes: p.opts.CacheBytes,
+		Entries:    p.db.EntryCount(),
+		Hits:       atomic.LoadInt64(&p.counters.hits),
+		Misses:     atomic.LoadInt64(&p.counters.misses),
+		EngineHits: atomic.LoadInt64(&p.counters.engineHits),
+		Admitted:   atomic.LoadInt64(&p.counters.admitted),
+		Rejected:   atomic.LoadInt64(&p.counters.rejected),
+		Evictions:  p.db.EvacuateCount(),
+		Expired:    p.db.ExpiredCount(),
+	}
+}
+
+// doorkeeper remembers the keys seen since the last reset in a bloom filter,
+// it is reset after as many keys as it has bits/8 so that old accesses are forgotten
+type doorkeeper struct {
+	mu    sync.Mutex
+	bits  []uint64
+	added int
+	limit int
+}
+
+func newDoorkeeper(cacheBytes int) *doorkeeper {
+	// about one bit per 64 cached bytes
+	words := cacheBytes / 64 / 64
+	if words < 1024 {
+		words = 1024
+	}
+	return &doorkeeper{bits: make([]uint64, words), limit: words * 64 / 8}
+}
+
+// seen reports whether key was seen before and records it
+func (d *doorkeeper) seen(key string) bool {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	sum := h.Sum64()
+	n := uint64(len(d.bits) * 64)
+	a, b := (sum&0xffffffff)%n, (sum>>32)%n
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.bits[a/64]&(1<<(a%64)) != 0 && d.bits[b/64]&(1<<(b%64)) != 0 {
+		return true
+	}
+	d.bits[a/64] |= 1 << (a % 64)
+	d.bits[b/64] |= 1 << (b % 64)
+	d.added++
+	if d.added >= d.limit {
+		for i := range d.bits {
+			d.bits[i] = 0
+		}
+		d.added = 0
+	}
+	return false
+}
+
+// admit decides whether key is put into the cache
+func (p *Store) admit(key string) bool {
+	if p.doorkeeper != nil && !p.doorkeeper.seen(key) {
+		atomic.AddInt64(&p.counters.rejected, 1)
+		return false
+	}
+	atomic.AddInt64(&p.counters.admitted, 1)
+	return true
+}
+
+// cacheSet puts the value into the cache with the time left to live of expireAt
+func (p *Store) cacheSet(key string, value []byte, expireAt uint64) {
+	ttl := 0
+	if expireAt != 0 {
+		ttl = int(int64(expireAt) - time.Now().Unix())
+		if ttl <= 0 {
+			p.db.Del([]byte(key))
+			return
+		}
+	}
+	// values larger than 1/1024 of the cache are refused by freecache, they are only in the engine
+	if err := p.db.Set([]byte(key), value, ttl); err != nil {
+		p.db.Del([]byte(key))
+	}
+}
+
+var errExpired = errors.New("store: expired")
+
+func encodeValue(value []byte, expireAt uint64) []byte {
+	data := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(data, expireAt)
+	copy(data[8:], value)
+	return data
+}
+
+// engineGet returns the value and the expiry of key in the engine,
+// leveldb.ErrNotFound if it does not exist and errExpired if it has expired
+func (p *Store) engineGet(key string) (value []byte, expireAt uint64, err error) {
+	data, err := p.engine.Get([]byte(key), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(data) < 8 {
+		return nil, 0, fmt.Errorf("store: corrupted value of %s", key)
+	}
+	expireAt = binary.BigEndian.Uint64(data)
+	if expireAt != 0 && int64(expireAt) <= time.Now().Unix() {
+		return nil, 0, errExpired
+	}
+	return data[8:], expireAt, nil
+}
+
+// purge removes key and its history from the engine if it is still expired
+func (p *Store) purge(key string) {
+	p.mu.Lock()
+	_, _, err := p.engineGet(key)
+	if err == errExpired {
+		p.engine.Delete([]byte(key), nil)
+		p.db.Del([]byte(key))
+	}
+	p.mu.Unlock()
+	// the history lock is never taken under the store lock, the history reads the store after releasing its own
+	if err == errExpired {
+		p.DropHistory(key)
+	}
+}
+
+// openEngine opens the leveldb engine and rebuilds the index from it, expired keys are dropped
+func (p *Store) openEngine(path string) error {
+	var err error
+	p.engine, err = leveldb.OpenFile(path, nil)
+	if err != nil {
+		return err
+	}
+	expired := make([][]byte, 0)
+	iter := p.engine.NewIterator(nil, nil)
+	now := uint64(time.Now().Unix())
+	for iter.Next() {
+		if len(iter.Value()) < 8 {
+			continue
+		}
+		expireAt := binary.BigEndian.Uint64(iter.Value())
+		if expireAt != 0 && expireAt <= now {
+			expired = append(expired, append([]byte{}, iter.Key()...))
+			continue
+		}
+		p.index.Put(iter.Key(), nil)
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	for _, key := range expired {
+		p.engine.Delete(key, nil)
+	}
+	return nil
+}
+
+func (p *Store) tieredPut(key string, value string, seconds int) {
+	var expireAt uint64
+	if seconds > 0 {
+		expireAt = uint64(time.Now().Unix()) + uint64(seconds)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.engine.Put([]byte(key), encodeValue([]byte(value), expireAt), nil); err != nil {
+		util.EPrintf("Put key %s failed, err: %s", key, err)
+		return
+	}
+	// a key already in the cache is always updated, it must not keep the old value
+	if p.cached(key) || p.admit(key) {
+		p.cacheSet(key, []byte(value), expireAt)
+	}
+	p.indexPut(key)
+}
+
+func (p *Store) tieredGet(key string) []byte {
+	if value, err := p.db.Get([]byte(key)); err == nil {
+		atomic.AddInt64(&p.counters.hits, 1)
+		return value
+	}
+	atomic.AddInt64(&p.counters.misses, 1)
+	// the fill holds the read lock, so a write between the read of the engine and the fill cannot be overwritten by the old value
+	p.mu.RLock()
+	value, expireAt, err := p.engineGet(key)
+	if err == nil {
+		atomic.AddInt64(&p.counters.engineHits, 1)
+		if p.admit(key) {
+			p.cacheSet(key, value, expireAt)
+		}
+	}
+	p.mu.RUnlock()
+	if err == errExpired {
+		p.purge(key)
+		return nil
+	}
+	if err != nil {
+		if err != leveldb.ErrNotFound {
+			util.EPrintf("Get key %s failed, err: %s", key, err)
+		}
+		return nil
+	}
+	return value
+}
+
+func (p *Store) tieredDelete(key string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, _, err := p.engineGet(key)
+	p.indexDelete(key)
+	p.db.Del([]byte(key))
+	if err == leveldb.ErrNotFound {
+		return false
+	}
+	if derr := p.engine.Delete([]byte(key), nil); derr != nil {
+		util.EPrintf("Delete key %s failed, err: %s", key, derr)
+	}
+	return err == nil
+}
+
+func (p *Store) tieredExpire(key string, seconds int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	value, _, err := p.engineGet(key)
+	if err != nil {
+		return false
+	}
+	var expireAt uint64
+	if seconds > 0 {
+		expireAt = uint64(time.Now().Unix()) + uint64(seconds)
+	}
+	if err := p.engine.Put([]byte(key), encodeValue(value, expireAt), nil); err != nil {
+		util.EPrintf("Expire key %s failed, err: %s", key, err)
+		return false
+	}
+	p.db.Touch([]byte(key), seconds)
+	return true
+}
+
+func (p *Store) tieredTTL(key string) (seconds uint32, ok bool) {
+	if seconds, err := p.db.TTL([]byte(key)); err == nil {
+		return seconds, true
+	}
+	_, expireAt, err := p.engineGet(key)
+	if err != nil {
+		return 0, false
+	}
+	if expireAt == 0 {
+		return 0, true
+	}
+	return uint32(int64(expireAt) - time.Now().Unix()), true
+}
+
+// MoveAside renames the engine before a snapshot is restored into the store at startup and opens an empty one
+func (p *Store) MoveAside() (string, error) {
+	if p.engine == nil {
+		return "", nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.engine.Close(); err != nil {
+		return "", err
+	}
+	aside := fmt.Sprintf("%s.%d", p.enginePath, time.Now().Unix())
+	if err := os.Rename(p.enginePath, aside); err != nil {
+		return "", err
+	}
+	p.db.Clear()
+	p.indexReset()
+	var err error
+	p.engine, err = leveldb.OpenFile(p.enginePath, nil)
+	return aside, err
+}
@@ -0,0 +1,250 @@
+package store
+
+/*
+	快照的读取和载入
+	View: 固定某一时刻的所有key，之后不持锁逐个读取。tiered引擎使用leveldb的快照；memory引擎复制key的列表，值按多版本历史在固定的vectorclock上读取
+	Staging: 载入的快照先写入临时的leveldb(<dbPath>/staging)，完整校验之后Commit才替换store中的key，载入失败时store不变
+	tiered引擎Commit时staging直接替换引擎的目录，memory引擎把staging中的key逐个写入缓存
+*/
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+)
+
+// ViewEntry is a key of a View
+type ViewEntry struct {
+	Key   string
+	Value []byte
+	// seconds left to live, 0 if the key does not expire
+	TTL uint32
+	// last write of the key at the clock of the view, no vectorclock if it is unknown
+	Version Version
+}
+
+// View is a consistent read-only copy of the keys, see NewView
+type View struct {
+	p     *Store
+	clock map[string]int32
+	// tiered
+	snap *leveldb.Snapshot
+	iter iterator.Iterator
+	// memory
+	keys []string
+	next int
+}
+
+// NewView pins the keys at clock, the caller blocks the writes while the view is created, not while it is read.
+// Release has to be called
+func (p *Store) NewView(clock map[string]int32) (*View, error) {
+	v := &View{p: p, clock: clock}
+	if p.engine != nil {
+		p.mu.RLock()
+		defer p.mu.RUnlock()
+		snap, err := p.engine.GetSnapshot()
+		if err != nil {
+			return nil, err
+		}
+		v.snap = snap
+		v.iter = snap.NewIterator(nil, nil)
+		return v, nil
+	}
+	p.indexMu.RLock()
+	defer p.indexMu.RUnlock()
+	iter := p.index.NewIterator(nil)
+	for iter.Next() {
+		v.keys = append(v.keys, string(iter.Key()))
+	}
+	iter.Release()
+	return v, nil
+}
+
+// recorded returns the newest version of key in the history covered by the clock of the view
+func (v *View) recorded(key string) (Version, bool) {
+	h := &v.p.history
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	kh, ok := h.keys[key]
+	if !ok {
+		return Version{}, false
+	}
+	for i := len(kh.versions) - 1; i >= 0; i-- {
+		if covers(v.clock, kh.versions[i].VectorClock) {
+			return kh.versions[i], true
+		}
+	}
+	return Version{}, false
+}
+
+// Next returns the next key in order, false after the last one. With the memory engine ErrSnapshotTooOld
+// means that the history of a key no longer reaches back to the view, it has to be taken again
+func (v *View) Next() (ViewEntry, bool, error) {
+	if v.iter != nil {
+		now := uint64(time.Now().Unix())
+		for v.iter.Next() {
+			data := v.iter.Value()
+			if len(data) < 8 {
+				continue
+			}
+			expireAt := binary.BigEndian.Uint64(data)
+			if expireAt != 0 && expireAt <= now {
+				continue
+			}
+			entry := ViewEntry{
+				Key:   string(v.iter.Key()),
+				Value: append([]byte{}, data[8:]...),
+			}
+			if expireAt != 0 {
+				entry.TTL = uint32(expireAt - now)
+			}
+			entry.Version, _ = v.recorded(entry.Key)
+			return entry, true, nil
+		}
+		return ViewEntry{}, false, v.iter.Error()
+	}
+	for v.next < len(v.keys) {
+		key := v.keys[v.next]
+		v.next++
+		version, found, err := v.p.versionWhere(key, func(version Version) bool {
+			return covers(v.clock, version.VectorClock)
+		})
+		if err != nil {
+			return ViewEntry{}, false, err
+		}
+		if !found {
+			continue
+		}
+		ttl, ok := v.p.TTL(key)
+		if !ok {
+			continue
+		}
+		return ViewEntry{Key: key, Value: version.Value, TTL: ttl, Version: version}, true, nil
+	}
+	return ViewEntry{}, false, nil
+}
+
+func (v *View) Release() {
+	if v.iter != nil {
+		v.iter.Release()
+		v.snap.Release()
+	}
+}
+
+// Staging collects the keys of a snapshot being restored, nothing is visible before Commit
+type Staging struct {
+	p    *Store
+	path string
+	// engine format, see encodeValue
+	data *leveldb.DB
+	// the versions of the keys which have one, json
+	versions *leveldb.DB
+}
+
+type stagedVersion struct {
+	VectorClock map[string]int32
+	Timestamp   int64
+}
+
+// NewStaging opens an empty staging area, Commit or Abort has to be called
+func (p *Store) NewStaging() (*Staging, error) {
+	s := &Staging{p: p, path: filepath.Join(p.path, "staging")}
+	if err := os.RemoveAll(s.path); err != nil {
+		return nil, err
+	}
+	var err error
+	if s.data, err = leveldb.OpenFile(filepath.Join(s.path, "data"), nil); err != nil {
+		return nil, err
+	}
+	if s.versions, err = leveldb.OpenFile(filepath.Join(s.path, "versions"), nil); err != nil {
+		s.data.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Put stages key, ttl is the seconds left to live, 0 if it does not expire; vc and ts are the version of the value
+func (s *Staging) Put(key string, value string, ttl uint32, vc map[string]int32, ts int64) error {
+	var expireAt uint64
+	if ttl > 0 {
+		expireAt = uint64(time.Now().Unix()) + uint64(ttl)
+	}
+	if err := s.data.Put([]byte(key), encodeValue([]byte(value), expireAt), nil); err != nil {
+		return err
+	}
+	if vc == nil && ts == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(stagedVersion{VectorClock: vc, Timestamp: ts})
+	return s.versions.Put([]byte(key), data, nil)
+}
+
+func (s *Staging) close() {
+	s.data.Close()
+	s.versions.Close()
+}
+
+func (s *Staging) Abort() {
+	s.close()
+	os.RemoveAll(s.path)
+}
+
+// Commit replaces the keys of the store by the staged ones, the caller blocks the writes of the store.
+// loaded is called for every key which has not expired; with the tiered engine the staging data becomes the engine
+func (s *Staging) Commit(loaded func(key string, value string, ttl uint32, version Version)) (keys int, err error) {
+	defer s.Abort()
+	p := s.p
+	iter := s.data.NewIterator(nil, nil)
+	now := uint64(time.Now().Unix())
+	for iter.Next() {
+		expireAt := binary.BigEndian.Uint64(iter.Value())
+		if expireAt != 0 && expireAt <= now {
+			continue
+		}
+		key, value := string(iter.Key()), string(iter.Value()[8:])
+		var ttl uint32
+		if expireAt != 0 {
+			ttl = uint32(expireAt - now)
+		}
+		version := Version{Value: []byte(value)}
+		if data, err := s.versions.Get(iter.Key(), nil); err == nil {
+			var staged stagedVersion
+			if json.Unmarshal(data, &staged) == nil {
+				version.VectorClock, version.Timestamp = staged.VectorClock, staged.Timestamp
+				p.AddVersion(key, version.Value, false, version.VectorClock, version.Timestamp)
+			}
+		}
+		if p.engine == nil {
+			p.PutTTL(key, value, int(ttl))
+		}
+		loaded(key, value, ttl, version)
+		keys++
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil || p.engine == nil {
+		return keys, err
+	}
+	s.close()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.engine.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.RemoveAll(p.enginePath); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(filepath.Join(s.path, "data"), p.enginePath); err != nil {
+		return 0, err
+	}
+	p.db.Clear()
+	p.indexReset()
+	if err := p.openEngine(p.enginePath); err != nil {
+		return 0, err
+	}
+	return p.indexLen(), nil
+}
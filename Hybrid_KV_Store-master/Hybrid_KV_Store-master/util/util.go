@@ -160,12 +160,3 @@ func LoadInt(counts sync.Map, key string) int {
 	}
 	return val.(int)
 }
-
-/* Map相关 */
-func MakeMap(addresses []string) map[string]int32 {
-	res := make(map[string]int32)
-	for _, address := range addresses {
-		res[address+"1"] = 0
-	}
-	return res
-}
@@ -0,0 +1,264 @@
+package wal
+
+/*
+	预写日志(WAL): 节点应用的每一组写在写入store之前按应用的顺序追加到本地的分段日志，节点重启时按顺序重放到store
+	一条记录是applyLogsLocked的一次调用: 一组写以及它们的vectorclock和HLC时间戳，段文件的格式见seglog
+	所有节点都已经应用的写(因果稳定)不再需要保存: 一个段中所有记录的vectorclock都被稳定的vectorclock覆盖之后，删除这个段
+	被删除的记录的vectorclock合并保存在truncated文件中，重启之后节点的vectorclock不会回退
+	带TTL的Put和Expire在日志中保存过期的绝对时间，重放时换算成剩余的秒数，已经过期的写作为Delete重放
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/seglog"
+)
+
+const truncatedFile = "truncated"
+
+// now is replaced by the tests
+var now = time.Now
+
+// Record is a group of writes applied as one step
+type Record struct {
+	Logs        []config.Log
+	VectorClock map[string]int32
+	HLC         int64
+	// internal address of the node which accepted the writes
+	Origin string `json:",omitempty"`
+}
+
+type segment struct {
+	first uint64
+	// merge of the vectorclocks of the records in the segment
+	vc map[string]int32
+}
+
+type Log struct {
+	mu       sync.Mutex
+	dir      string
+	log      *seglog.Log
+	segments []*segment
+	// merge of the vectorclocks of the truncated records
+	truncated map[string]int32
+}
+
+// Open calls replay for every record of the log in dir, in order, then continues the log.
+// A corrupted record stops the replay with an error
+func Open(dir string, opts seglog.Options, replay func(Record)) (*Log, error) {
+	// segments are only deleted by Truncate
+	opts.MaxSegments = 0
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	l := &Log{
+		dir:       dir,
+		truncated: make(map[string]int32),
+	}
+	data, err := os.ReadFile(filepath.Join(dir, truncatedFile))
+	if err == nil {
+		if err := json.Unmarshal(data, &l.truncated); err != nil {
+			return nil, fmt.Errorf("wal: %s: %w", truncatedFile, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	firsts, err := seglog.Segments(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, first := range firsts {
+		l.segments = append(l.segments, &segment{first: first, vc: make(map[string]int32)})
+	}
+	i := 0
+	err = seglog.Read(dir, 0, func(seq uint64, data []byte) error {
+		var r Record
+		if err := json.Unmarshal(data, &r); err != nil {
+			return fmt.Errorf("wal: record %d: %w", seq, err)
+		}
+		for i+1 < len(firsts) && firsts[i+1] <= seq {
+			i++
+		}
+		merge(l.segments[i].vc, r.VectorClock)
+		r.Logs = remaining(r.Logs, now().Unix())
+		replay(r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	l.log, err = seglog.Open(dir, opts)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Append writes the record and returns its sequence number, the record is durable according to the sync policy
+func (l *Log) Append(r Record) (uint64, error) {
+	r.Logs = deadlines(r.Logs, now().Unix())
+	data, err := json.Marshal(r)
+	if err != nil {
+		return 0, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	seq, err := l.log.Append(data)
+	if err != nil {
+		return 0, err
+	}
+	first := l.log.CurrentSegment()
+	if len(l.segments) == 0 || l.segments[len(l.segments)-1].first != first {
+		l.segments = append(l.segments, &segment{first: first, vc: make(map[string]int32)})
+	}
+	merge(l.segments[len(l.segments)-1].vc, r.VectorClock)
+	return seq, nil
+}
+
+// LastSeq returns the sequence number of the last appended record, 0 if none
+func (l *Log) LastSeq() uint64 {
+	return l.log.LastSeq()
+}
+
+// Truncate deletes the oldest segments whose records are all covered by stable and numbered below before.
+// The segment being appended is kept. Returns the number of deleted segments
+func (l *Log) Truncate(stable map[string]int32, before uint64) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := 0
+	for n+1 < len(l.segments) && l.segments[n+1].first <= before && covers(stable, l.segments[n].vc) {
+		n++
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	truncated := make(map[string]int32, len(l.truncated))
+	merge(truncated, l.truncated)
+	for _, seg := range l.segments[:n] {
+		merge(truncated, seg.vc)
+	}
+	// the clock of the records is saved before they are deleted
+	if err := writeFile(filepath.Join(l.dir, truncatedFile), truncated); err != nil {
+		return 0, err
+	}
+	l.truncated = truncated
+	removed, err := l.log.RemoveBefore(l.segments[n].first)
+	l.segments = l.segments[n:]
+	return removed, err
+}
+
+// Truncated returns the merge of the vectorclocks of the deleted records
+func (l *Log) Truncated() map[string]int32 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	truncated := make(map[string]int32, len(l.truncated))
+	merge(truncated, l.truncated)
+	return truncated
+}
+
+// Segments returns the number of segments of the log
+func (l *Log) Segments() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.segments)
+}
+
+func (l *Log) Close() error {
+	return l.log.Close()
+}
+
+// writeFile replaces the file atomically
+func writeFile(path string, vc map[string]int32) error {
+	data, err := json.Marshal(vc)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// deadlines returns a copy of logs in which the writes with a time to live also carry the unix time they expire at
+func deadlines(logs []config.Log, now int64) []config.Log {
+	var stamped []config.Log
+	for i, log := range logs {
+		seconds := log.TTL
+		if log.Option == "Expire" {
+			seconds, _ = strconv.Atoi(log.Value)
+		} else if log.Option != "Put" {
+			continue
+		}
+		if seconds <= 0 {
+			continue
+		}
+		if stamped == nil {
+			stamped = append([]config.Log(nil), logs...)
+		}
+		stamped[i].ExpireAt = now + int64(seconds)
+	}
+	if stamped == nil {
+		return logs
+	}
+	return stamped
+}
+
+// remaining turns the expiry times of replayed logs back into the seconds left, the writes which expired in the meantime
+// delete their key
+func remaining(logs []config.Log, now int64) []config.Log {
+	for i := range logs {
+		log := &logs[i]
+		if log.ExpireAt == 0 {
+			continue
+		}
+		seconds := log.ExpireAt - now
+		log.ExpireAt = 0
+		switch {
+		case seconds <= 0:
+			*log = config.Log{Option: "Delete", Key: log.Key}
+		case log.Option == "Expire":
+			log.Value = strconv.FormatInt(seconds, 10)
+		default:
+			log.TTL = int(seconds)
+		}
+	}
+	return logs
+}
+
+func merge(dst map[string]int32, vc map[string]int32) {
+	for id, counter := range vc {
+		if counter > dst[id] {
+			dst[id] = counter
+		}
+	}
+}
+
+// covers reports whether every entry of vc is not greater than the one of clock
+func covers(clock map[string]int32, vc map[string]int32) bool {
+	for id, counter := range vc {
+		if counter > clock[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+package wal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/config"
+	"github.com/JasonLou99/Hybrid_KV_Store/seglog"
+)
+
+func record(counter int32) Record {
+	return Record{
+		Logs:        []config.Log{{Option: "Put", Key: "k", Value: "v", TTL: int(counter)}},
+		VectorClock: map[string]int32{"a": counter},
+		HLC:         int64(counter),
+		Origin:      "a",
+	}
+}
+
+func replayAll(t *testing.T, dir string) ([]Record, *Log) {
+	t.Helper()
+	var records []Record
+	l, err := Open(dir, seglog.Options{SegmentBytes: 256, Sync: seglog.SyncAlways}, func(r Record) {
+		records = append(records, r)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return records, l
+}
+
+// setNow stops the clock of the log at unix
+func setNow(t *testing.T, unix int64) {
+	t.Helper()
+	now = func() time.Time { return time.Unix(unix, 0) }
+	t.Cleanup(func() { now = time.Now })
+}
+
+func TestReplay(t *testing.T) {
+	setNow(t, 1000)
+	dir := t.TempDir()
+	_, l := replayAll(t, dir)
+	for i := int32(1); i <= 20; i++ {
+		if _, err := l.Append(record(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	l.Close()
+	records, l := replayAll(t, dir)
+	defer l.Close()
+	if len(records) != 20 {
+		t.Fatalf("replayed %d records, expected 20", len(records))
+	}
+	for i, r := range records {
+		if !reflect.DeepEqual(r, record(int32(i+1))) {
+			t.Fatalf("record %d is %+v", i+1, r)
+		}
+	}
+	if l.LastSeq() != 20 {
+		t.Fatalf("LastSeq %d, expected 20", l.LastSeq())
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	dir := t.TempDir()
+	_, l := replayAll(t, dir)
+	for i := int32(1); i <= 20; i++ {
+		if _, err := l.Append(record(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if l.Segments() < 3 {
+		t.Fatalf("%d segments, the log did not roll", l.Segments())
+	}
+	removed, err := l.Truncate(map[string]int32{"a": 10}, l.LastSeq()+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed == 0 {
+		t.Fatal("no segment covered by the stable clock was removed")
+	}
+	truncated := l.Truncated()["a"]
+	if truncated == 0 || truncated > 10 {
+		t.Fatalf("truncated clock %d, expected 1 to 10", truncated)
+	}
+	l.Close()
+
+	// the records of the removed segments are not replayed, their clock survives
+	records, l := replayAll(t, dir)
+	defer l.Close()
+	if l.Truncated()["a"] != truncated {
+		t.Fatalf("truncated clock %d after reopening, expected %d", l.Truncated()["a"], truncated)
+	}
+	if len(records) == 0 {
+		t.Fatal("no record replayed")
+	}
+	if records[0].VectorClock["a"] != truncated+1 || records[len(records)-1].VectorClock["a"] != 20 {
+		t.Fatalf("replayed records %d to %d, expected %d to 20", records[0].VectorClock["a"], records[len(records)-1].VectorClock["a"], truncated+1)
+	}
+}
+
+func TestReplayExpiry(t *testing.T) {
+	setNow(t, 1000)
+	dir := t.TempDir()
+	_, l := replayAll(t, dir)
+	logs := []config.Log{
+		{Option: "Put", Key: "a", Value: "v", TTL: 60},
+		{Option: "Put", Key: "b", Value: "v", TTL: 5},
+		{Option: "Expire", Key: "c", Value: "60"},
+		{Option: "Expire", Key: "d", Value: "5"},
+		{Option: "Put", Key: "e", Value: "v"},
+	}
+	if _, err := l.Append(Record{Logs: logs, VectorClock: map[string]int32{"a": 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if logs[0].ExpireAt != 0 {
+		t.Fatal("Append changed the logs of the caller")
+	}
+	l.Close()
+
+	// 10 seconds later the time to live left is replayed, the writes which expired delete their key
+	setNow(t, 1010)
+	records, l := replayAll(t, dir)
+	defer l.Close()
+	expected := []config.Log{
+		{Option: "Put", Key: "a", Value: "v", TTL: 50},
+		{Option: "Delete", Key: "b"},
+		{Option: "Expire", Key: "c", Value: "50"},
+		{Option: "Delete", Key: "d"},
+		{Option: "Put", Key: "e", Value: "v"},
+	}
+	if len(records) != 1 || !reflect.DeepEqual(records[0].Logs, expected) {
+		t.Fatalf("replayed %+v, expected %+v", records, expected)
+	}
+}
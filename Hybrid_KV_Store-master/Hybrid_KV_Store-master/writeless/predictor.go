@@ -0,0 +1,141 @@
+package writeless
+
+/*
+	写预测器：预测某个key在下一次读之前会有多少次写
+	节点缓存的写次数(putCountsInProxy)达到预测值时，才同步历史写
+*/
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Counters of one key on one node, the same as the sync.Maps kept by kvserver
+type KeyStats struct {
+	// valid gets served, including the current one
+	Gets int
+	// puts received by this node (putCountsInTotal)
+	TotalPuts int
+	// puts buffered since the last history sync (putCountsInProxy)
+	ProxyPuts int
+}
+
+// row is the row of the trace being replayed: Predict is asked for the puts before the gets of row,
+// Observe is called on a get of row. Predictors of the running kvserver have no row and ignore it
+type Predictor interface {
+	// Predict returns how many puts of key may be buffered before syncing them to peers
+	Predict(key string, row int) int
+	// Observe is called on every valid get of key
+	Observe(key string, row int, stats KeyStats)
+}
+
+// AveragePredictor is the policy used by startInWritelessCausal: 取平均
+type AveragePredictor struct {
+	mu      sync.Mutex
+	predict map[string]int
+}
+
+func NewAveragePredictor() *AveragePredictor {
+	return &AveragePredictor{predict: make(map[string]int)}
+}
+
+func (p *AveragePredictor) Predict(key string, row int) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.predict[key]
+}
+
+func (p *AveragePredictor) Observe(key string, row int, stats KeyStats) {
+	if stats.Gets == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.predict[key] = (stats.TotalPuts + stats.ProxyPuts) / stats.Gets
+}
+
+// FixedPredictor always predicts the same counts, Counts=0 syncs every put like plain causal
+type FixedPredictor struct {
+	Counts int
+}
+
+func (p FixedPredictor) Predict(key string, row int) int {
+	return p.Counts
+}
+
+func (p FixedPredictor) Observe(key string, row int, stats KeyStats) {}
+
+// SeriesPredictor replays precomputed predictions (e.g. the output of the LSTM) of the trace of a key,
+// the n-th value predicts the puts before the gets of row n. It does not count gets, the nodes which
+// serve the gets of a key change from row to row
+type SeriesPredictor struct {
+	series []int
+}
+
+func NewSeriesPredictor(series []int) *SeriesPredictor {
+	return &SeriesPredictor{series: series}
+}
+
+// OraclePredictor knows the real write counts of the trace, it is the upper bound of any predictor
+type OraclePredictor struct {
+	trace []TraceRow
+}
+
+// NewOraclePredictor predicts the write counts of every row of trace
+func NewOraclePredictor(trace []TraceRow) *OraclePredictor {
+	return &OraclePredictor{trace: trace}
+}
+
+func (p *OraclePredictor) Predict(key string, row int) int {
+	if len(p.trace) == 0 {
+		return 0
+	}
+	if row >= len(p.trace) {
+		row = len(p.trace) - 1
+	}
+	return p.trace[row].WriteCounts
+}
+
+func (p *OraclePredictor) Observe(key string, row int, stats KeyStats) {}
+
+// LoadSeries reads predictions from the first column of a csv file, non-numeric rows (header) are skipped
+func LoadSeries(filepath string) ([]int, error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	series := make([]int, 0, len(rows))
+	for _, row := range rows {
+		if len(row) == 0 {
+			continue
+		}
+		val, err := strconv.ParseFloat(row[0], 64)
+		if err != nil {
+			continue
+		}
+		series = append(series, int(val+0.5))
+	}
+	return series, nil
+}
+
+func (p *SeriesPredictor) Predict(key string, row int) int {
+	if len(p.series) == 0 {
+		return 0
+	}
+	if row >= len(p.series) {
+		row = len(p.series) - 1
+	}
+	return p.series[row]
+}
+
+func (p *SeriesPredictor) Observe(key string, row int, stats KeyStats) {}
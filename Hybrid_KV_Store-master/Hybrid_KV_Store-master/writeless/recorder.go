@@ -0,0 +1,190 @@
+package writeless
+
+/*
+	访问轨迹记录器：记录每个key的读写事件，定期输出训练LSTM用的csv
+	每个key一个文件，格式与 dataset/btcusd_low.csv 相同: read_time,write_counts,period
+	每次读输出一行：读的时间(ms)、距上次读之间的写次数、距上次读的时间间隔(ms)
+*/
+
+import (
+	"encoding/csv"
+	"hash/fnv"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/util"
+)
+
+type RecorderConfig struct {
+	// output directory, one csv per key
+	Dir string
+	// fraction of keys to record, a key is either always or never recorded
+	SampleRate float64
+	// only keys with one of the prefixes are recorded, empty means all
+	KeyPrefixes []string
+	// only keys matching it are recorded, nil means all
+	KeyPattern *regexp.Regexp
+	// at most MaxKeys keys are tracked, 0 means no limit
+	MaxKeys int
+	// interval of writing the rows to the files
+	FlushInterval time.Duration
+}
+
+// access state of one key
+type keyTrace struct {
+	// unix milliseconds of the last read, 0 if never read
+	lastRead    int64
+	writeCounts int
+	// rows not written yet
+	rows [][]string
+}
+
+type Recorder struct {
+	conf RecorderConfig
+	mu   sync.Mutex
+	keys map[string]*keyTrace
+}
+
+func NewRecorder(conf RecorderConfig) (*Recorder, error) {
+	if err := os.MkdirAll(conf.Dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		conf: conf,
+		keys: make(map[string]*keyTrace),
+	}, nil
+}
+
+// sampled applies the key filters and the sampling
+func (r *Recorder) sampled(key string) bool {
+	if len(r.conf.KeyPrefixes) > 0 {
+		match := false
+		for _, prefix := range r.conf.KeyPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	if r.conf.KeyPattern != nil && !r.conf.KeyPattern.MatchString(key) {
+		return false
+	}
+	if r.conf.SampleRate >= 1 {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return float64(h.Sum32()%10000) < r.conf.SampleRate*10000
+}
+
+// trace returns the state of key, nil if the key is not recorded
+func (r *Recorder) trace(key string) *keyTrace {
+	kt, ok := r.keys[key]
+	if ok {
+		return kt
+	}
+	if !r.sampled(key) {
+		return nil
+	}
+	if r.conf.MaxKeys > 0 && len(r.keys) >= r.conf.MaxKeys {
+		return nil
+	}
+	kt = &keyTrace{}
+	r.keys[key] = kt
+	return kt
+}
+
+// RecordPut is safe to call on a nil Recorder
+func (r *Recorder) RecordPut(key string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if kt := r.trace(key); kt != nil {
+		kt.writeCounts++
+	}
+}
+
+// RecordGet is safe to call on a nil Recorder
+func (r *Recorder) RecordGet(key string) {
+	if r == nil {
+		return
+	}
+	now := time.Now().UnixMilli()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kt := r.trace(key)
+	if kt == nil {
+		return
+	}
+	period := int64(0)
+	if kt.lastRead > 0 {
+		period = now - kt.lastRead
+	}
+	kt.rows = append(kt.rows, []string{
+		strconv.FormatFloat(float64(now), 'f', 1, 64),
+		strconv.FormatFloat(float64(kt.writeCounts), 'f', 1, 64),
+		strconv.FormatFloat(float64(period), 'f', 1, 64),
+	})
+	kt.lastRead = now
+	kt.writeCounts = 0
+}
+
+// Flush appends the pending rows to the csv of every key
+func (r *Recorder) Flush() {
+	r.mu.Lock()
+	pending := make(map[string][][]string)
+	for key, kt := range r.keys {
+		if len(kt.rows) > 0 {
+			pending[key] = kt.rows
+			kt.rows = nil
+		}
+	}
+	r.mu.Unlock()
+	for key, rows := range pending {
+		if err := r.appendRows(key, rows); err != nil {
+			util.EPrintf("Recorder flush key %s failed, err: %s", key, err)
+		}
+	}
+}
+
+func (r *Recorder) appendRows(key string, rows [][]string) error {
+	path := filepath.Join(r.conf.Dir, url.PathEscape(key)+".csv")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(file)
+	if info.Size() == 0 {
+		writer.Write(TraceHeader)
+	}
+	writer.WriteAll(rows)
+	return writer.Error()
+}
+
+// Run flushes the rows every FlushInterval
+func (r *Recorder) Run() {
+	interval := r.conf.FlushInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	for {
+		time.Sleep(interval)
+		r.Flush()
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+/*
+	回放访问轨迹，评估writeless的预测策略
+	go run ./writeless/replay -trace ./writeless/dataset/btcusd_low.csv -nodes 3 -predictor average -format csv -out ./benchmark/result/replay.csv
+*/
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/JasonLou99/Hybrid_KV_Store/writeless"
+)
+
+func main() {
+	var tracePath = flag.String("trace", "./writeless/dataset/btcusd_low.csv", "Trace file (read_time,write_counts,period)")
+	var rows = flag.Int("rows", 0, "Only replay the first rows of the trace, 0 means all")
+	var nodes = flag.Int("nodes", 3, "Number of replicas")
+	var clients = flag.Int("clients", 1, "Concurrent clients replaying the trace")
+	var latency = flag.Float64("latency", 0, "One-way replication delay in ms")
+	var seed = flag.Int64("seed", 1, "Seed of the node switching")
+	var predictorName = flag.String("predictor", "average", "average, fixed, oracle or series")
+	var fixed = flag.Int("fixed", 0, "Predicted put counts of the fixed predictor")
+	var seriesPath = flag.String("series", "", "CSV of predicted put counts (first column) for the series predictor")
+	var format = flag.String("format", "json", "Output format: json or csv")
+	var out = flag.String("out", "", "Output file, stdout if empty")
+	flag.Parse()
+
+	trace, err := writeless.LoadTrace(*tracePath, *rows)
+	if err != nil {
+		fmt.Println("### Load trace failed:", err)
+		return
+	}
+	var newPredictor func() writeless.Predictor
+	switch *predictorName {
+	case "average":
+		newPredictor = func() writeless.Predictor { return writeless.NewAveragePredictor() }
+	case "fixed":
+		newPredictor = func() writeless.Predictor { return writeless.FixedPredictor{Counts: *fixed} }
+	case "oracle":
+		newPredictor = func() writeless.Predictor { return writeless.NewOraclePredictor(trace) }
+	case "series":
+		series, err := writeless.LoadSeries(*seriesPath)
+		if err != nil {
+			fmt.Println("### Load series failed:", err)
+			return
+		}
+		newPredictor = func() writeless.Predictor { return writeless.NewSeriesPredictor(series) }
+	default:
+		fmt.Println("### Wrong Predictor ! ###")
+		return
+	}
+
+	result := writeless.Simulate(trace, newPredictor, writeless.SimConfig{
+		Nodes:   *nodes,
+		Key:     "key",
+		Clients: *clients,
+		Latency: *latency,
+		Seed:    *seed,
+	})
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			fmt.Println("### Create output failed:", err)
+			return
+		}
+		defer file.Close()
+		w = file
+	}
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(result)
+	case "csv":
+		err = writeCSV(w, result.Reads)
+	default:
+		fmt.Println("### Wrong Format ! ###")
+		return
+	}
+	if err != nil {
+		fmt.Println("### Write result failed:", err)
+		return
+	}
+	s := result.Summary
+	fmt.Fprintf(os.Stderr, "reads: %v, puts: %v, messages causal/writeless: %v/%v (saved %.2f%%), forced syncs: %v (%.2f%%), stale reads: %v (%.2f%%), staleness mean/p99/max: %.0f/%.0f/%.0f, unsynced puts: %v\n",
+		s.Reads, s.Puts, s.CausalMessages, s.WritelessMessages, s.SavedRatio*100,
+		s.ForcedSyncs, s.ForcedSyncRatio*100, s.StaleReads, s.StaleReadRatio*100,
+		s.StalenessMean, s.StalenessP99, s.StalenessMax, s.UnsyncedPuts)
+}
+
+func writeCSV(w io.Writer, reads []writeless.ReadRecord) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"index", "client", "read_time", "node", "writes", "predict", "forced_sync", "stale", "causal_messages", "writeless_messages"})
+	for _, r := range reads {
+		writer.Write([]string{
+			strconv.Itoa(r.Index),
+			strconv.Itoa(r.Client),
+			strconv.FormatFloat(r.ReadTime, 'f', -1, 64),
+			strconv.Itoa(r.Node),
+			strconv.Itoa(r.Writes),
+			strconv.Itoa(r.Predict),
+			strconv.FormatBool(r.ForcedSync),
+			strconv.FormatBool(r.Stale),
+			strconv.Itoa(r.CausalMessages),
+			strconv.Itoa(r.WritelessMessages),
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
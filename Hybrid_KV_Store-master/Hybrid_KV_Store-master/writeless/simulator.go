@@ -0,0 +1,239 @@
+package writeless
+
+/*
+	离线回放模拟器：在进程内模拟 startInWritelessCausal 的同步逻辑
+	不需要部署集群就可以比较不同预测器节省的同步消息数、Get触发的历史同步次数和数据陈旧窗口
+*/
+
+import (
+	"math/rand"
+	"sort"
+)
+
+type SimConfig struct {
+	// number of replicas
+	Nodes int
+	// the key all trace operations are applied to, same as benchmarkFromCSV
+	Key string
+	// one-way replication delay in the unit of the trace (ms), added to every staleness window
+	Latency float64
+	// concurrent clients replaying the same trace, like benchmark -cnums
+	Clients int
+	// seed of the node switching after each read (随机切换下一个节点)
+	Seed int64
+}
+
+// Result of one read in the trace
+type ReadRecord struct {
+	Index    int     `json:"index"`
+	Client   int     `json:"client"`
+	ReadTime float64 `json:"read_time"`
+	Node     int     `json:"node"`
+	Writes   int     `json:"writes"`
+	// predictPutCounts after the read
+	Predict int `json:"predict"`
+	// the read found buffered puts on its node and synced them
+	ForcedSync bool `json:"forced_sync"`
+	// other replicas still buffered puts of the key, so the read missed them
+	Stale bool `json:"stale"`
+	// cumulative replication messages
+	CausalMessages    int `json:"causal_messages"`
+	WritelessMessages int `json:"writeless_messages"`
+}
+
+type Summary struct {
+	Nodes             int     `json:"nodes"`
+	Reads             int     `json:"reads"`
+	Puts              int     `json:"puts"`
+	CausalMessages    int     `json:"causal_messages"`
+	WritelessMessages int     `json:"writeless_messages"`
+	SavedMessages     int     `json:"saved_messages"`
+	SavedRatio        float64 `json:"saved_ratio"`
+	PredictionSyncs   int     `json:"prediction_syncs"`
+	ForcedSyncs       int     `json:"forced_syncs"`
+	ForcedSyncRatio   float64 `json:"forced_sync_ratio"`
+	StaleReads        int     `json:"stale_reads"`
+	StaleReadRatio    float64 `json:"stale_read_ratio"`
+	// staleness window of every put: time until its value is sent to the peers
+	StalenessMean float64 `json:"staleness_mean"`
+	StalenessP50  float64 `json:"staleness_p50"`
+	StalenessP99  float64 `json:"staleness_p99"`
+	StalenessMax  float64 `json:"staleness_max"`
+	// puts still buffered when the trace ends, they never reach the peers
+	UnsyncedPuts int `json:"unsynced_puts"`
+}
+
+type SimResult struct {
+	Summary Summary      `json:"summary"`
+	Reads   []ReadRecord `json:"reads"`
+}
+
+// state of one simulated kvserver
+type simNode struct {
+	putCountsInProxy map[string]int
+	putCountsInTotal map[string]int
+	getCountsInTotal map[string]int
+	// put time of every buffered put
+	pending map[string][]float64
+	// predictPutCounts of this node
+	predictor Predictor
+}
+
+func newSimNode(predictor Predictor) *simNode {
+	return &simNode{
+		predictor:        predictor,
+		putCountsInProxy: make(map[string]int),
+		putCountsInTotal: make(map[string]int),
+		getCountsInTotal: make(map[string]int),
+		pending:          make(map[string][]float64),
+	}
+}
+
+type simulator struct {
+	conf      SimConfig
+	nodes     []*simNode
+	staleness []float64
+	summary   Summary
+}
+
+// Simulate replays trace against an in-process model of the writeless-causal cluster,
+// every node gets its own predictor from newPredictor like predictPutCounts in kvserver
+func Simulate(trace []TraceRow, newPredictor func() Predictor, conf SimConfig) *SimResult {
+	if conf.Nodes <= 0 {
+		conf.Nodes = 1
+	}
+	if conf.Clients <= 0 {
+		conf.Clients = 1
+	}
+	if conf.Key == "" {
+		conf.Key = "key"
+	}
+	sim := &simulator{
+		conf:  conf,
+		nodes: make([]*simNode, conf.Nodes),
+	}
+	for i := range sim.nodes {
+		sim.nodes[i] = newSimNode(newPredictor())
+	}
+	sim.summary.Nodes = conf.Nodes
+	r := rand.New(rand.NewSource(conf.Seed))
+	result := &SimResult{Reads: make([]ReadRecord, 0, len(trace)*conf.Clients)}
+	// target node of every client
+	targets := make([]int, conf.Clients)
+	for i, row := range trace {
+		// puts of all clients are spread evenly over the period before the reads
+		start := row.ReadTime - row.Period
+		for j := 0; j < row.WriteCounts; j++ {
+			putTime := start + row.Period*float64(j+1)/float64(row.WriteCounts+1)
+			for _, target := range targets {
+				sim.put(target, i, putTime)
+			}
+		}
+		for c, target := range targets {
+			record := sim.get(target, i, row.ReadTime)
+			record.Index = i
+			record.Client = c
+			record.Writes = row.WriteCounts
+			result.Reads = append(result.Reads, record)
+			// 随机切换下一个节点
+			targets[c] = r.Intn(conf.Nodes+10) % conf.Nodes
+		}
+	}
+	for _, node := range sim.nodes {
+		sim.summary.UnsyncedPuts += len(node.pending[conf.Key])
+	}
+	sim.finish()
+	result.Summary = sim.summary
+	return result
+}
+
+// put is a put of row, before its gets
+func (sim *simulator) put(id int, row int, now float64) {
+	key := sim.conf.Key
+	node := sim.nodes[id]
+	sim.summary.Puts++
+	// plain causal sends every put to all peers
+	sim.summary.CausalMessages += sim.conf.Nodes - 1
+	// same as PutInWritelessCausal + startInWritelessCausal
+	node.putCountsInProxy[key]++
+	node.putCountsInTotal[key]++
+	node.pending[key] = append(node.pending[key], now)
+	if node.putCountsInProxy[key] >= node.predictor.Predict(key, row) {
+		sim.summary.PredictionSyncs++
+		sim.sync(node, now)
+	}
+}
+
+// get is a get of row
+func (sim *simulator) get(id int, row int, now float64) ReadRecord {
+	key := sim.conf.Key
+	node := sim.nodes[id]
+	sim.summary.Reads++
+	record := ReadRecord{ReadTime: now, Node: id}
+	for i, other := range sim.nodes {
+		if i != id && other.putCountsInProxy[key] != 0 {
+			record.Stale = true
+		}
+	}
+	if record.Stale {
+		sim.summary.StaleReads++
+	}
+	node.getCountsInTotal[key]++
+	node.predictor.Observe(key, row, KeyStats{
+		Gets:      node.getCountsInTotal[key],
+		TotalPuts: node.putCountsInTotal[key],
+		ProxyPuts: node.putCountsInProxy[key],
+	})
+	if node.putCountsInProxy[key] != 0 {
+		// Sync History Puts by Get
+		record.ForcedSync = true
+		sim.summary.ForcedSyncs++
+		sim.sync(node, now)
+	}
+	// the prediction for the puts of the next row
+	record.Predict = node.predictor.Predict(key, row+1)
+	record.CausalMessages = sim.summary.CausalMessages
+	record.WritelessMessages = sim.summary.WritelessMessages
+	return record
+}
+
+// sync sends the latest value of the key to all peers, which covers every buffered put
+func (sim *simulator) sync(node *simNode, now float64) {
+	key := sim.conf.Key
+	sim.summary.WritelessMessages += sim.conf.Nodes - 1
+	for _, putTime := range node.pending[key] {
+		sim.staleness = append(sim.staleness, now-putTime+sim.conf.Latency)
+	}
+	node.pending[key] = node.pending[key][:0]
+	node.putCountsInProxy[key] = 0
+}
+
+func (sim *simulator) finish() {
+	s := &sim.summary
+	s.SavedMessages = s.CausalMessages - s.WritelessMessages
+	if s.CausalMessages > 0 {
+		s.SavedRatio = float64(s.SavedMessages) / float64(s.CausalMessages)
+	}
+	if s.Reads > 0 {
+		s.ForcedSyncRatio = float64(s.ForcedSyncs) / float64(s.Reads)
+		s.StaleReadRatio = float64(s.StaleReads) / float64(s.Reads)
+	}
+	if len(sim.staleness) == 0 {
+		return
+	}
+	sort.Float64s(sim.staleness)
+	total := 0.0
+	for _, w := range sim.staleness {
+		total += w
+	}
+	s.StalenessMean = total / float64(len(sim.staleness))
+	s.StalenessP50 = percentile(sim.staleness, 0.50)
+	s.StalenessP99 = percentile(sim.staleness, 0.99)
+	s.StalenessMax = sim.staleness[len(sim.staleness)-1]
+}
+
+// sorted must be sorted ascending
+func percentile(sorted []float64, p float64) float64 {
+	idx := int(p * float64(len(sorted)-1))
+	return sorted[idx]
+}
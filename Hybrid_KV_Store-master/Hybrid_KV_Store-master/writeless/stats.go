@@ -0,0 +1,240 @@
+package writeless
+
+/*
+	读写统计：每个key的读次数和写次数，按半衰期指数衰减，只保留最近的访问模式
+	本地统计定期持久化，并通过gossip在节点间交换，预测值基于整个集群的读写模式
+*/
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// decayed counts of one key
+type Counts struct {
+	Gets float64 `json:"gets"`
+	Puts float64 `json:"puts"`
+	// unix milliseconds of the last decay
+	Updated int64 `json:"updated"`
+}
+
+// counts below it are dropped by Trim
+const minWeight = 0.01
+
+type peerStats struct {
+	received time.Time
+	counts   map[string]Counts
+}
+
+// chunks of a gossip round of a peer which are not all received yet
+type peerRound struct {
+	round    int64
+	received map[int]bool
+	counts   map[string]Counts
+}
+
+type Stats struct {
+	mu       sync.Mutex
+	halfLife time.Duration
+	maxKeys  int
+	local    map[string]*Counts
+	// stats gossiped by other nodes, replaced on every gossip
+	peers map[string]*peerStats
+	// incomplete gossip rounds of the peers
+	rounds map[string]*peerRound
+	// peer stats older than it are ignored
+	peerTTL time.Duration
+}
+
+// NewStats keeps at most maxKeys keys after every Trim, halfLife <= 0 means no decay
+func NewStats(halfLife time.Duration, maxKeys int, peerTTL time.Duration) *Stats {
+	return &Stats{
+		halfLife: halfLife,
+		maxKeys:  maxKeys,
+		local:    make(map[string]*Counts),
+		peers:    make(map[string]*peerStats),
+		rounds:   make(map[string]*peerRound),
+		peerTTL:  peerTTL,
+	}
+}
+
+// decay ages c to now
+func (s *Stats) decay(c *Counts, now int64) {
+	if s.halfLife > 0 && c.Updated > 0 && now > c.Updated {
+		factor := math.Pow(0.5, float64(now-c.Updated)/(float64(s.halfLife)/float64(time.Millisecond)))
+		c.Gets *= factor
+		c.Puts *= factor
+	}
+	c.Updated = now
+}
+
+func (s *Stats) add(key string, gets float64, puts float64) {
+	now := time.Now().UnixMilli()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.local[key]
+	if !ok {
+		c = &Counts{}
+		s.local[key] = c
+	}
+	s.decay(c, now)
+	c.Gets += gets
+	c.Puts += puts
+}
+
+func (s *Stats) AddGet(key string) {
+	s.add(key, 1, 0)
+}
+
+func (s *Stats) AddPut(key string) {
+	s.add(key, 0, 1)
+}
+
+// Local returns the counts of key on this node
+func (s *Stats) Local(key string) Counts {
+	now := time.Now().UnixMilli()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.local[key]
+	if !ok {
+		return Counts{}
+	}
+	s.decay(c, now)
+	return *c
+}
+
+// Cluster returns the counts of key summed over this node and all peers
+func (s *Stats) Cluster(key string) Counts {
+	now := time.Now()
+	res := s.Local(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, peer := range s.peers {
+		if s.peerTTL > 0 && now.Sub(peer.received) > s.peerTTL {
+			continue
+		}
+		c, ok := peer.counts[key]
+		if !ok {
+			continue
+		}
+		s.decay(&c, now.UnixMilli())
+		res.Gets += c.Gets
+		res.Puts += c.Puts
+	}
+	return res
+}
+
+// Predict returns the puts between two gets of key over the whole cluster, 取平均
+func (s *Stats) Predict(key string) int {
+	c := s.Cluster(key)
+	if c.Gets < 1 {
+		return 0
+	}
+	return int(c.Puts / c.Gets)
+}
+
+// Snapshot returns the local counts decayed to now, used for persistence and gossip
+func (s *Stats) Snapshot() map[string]Counts {
+	now := time.Now().UnixMilli()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make(map[string]Counts, len(s.local))
+	for key, c := range s.local {
+		s.decay(c, now)
+		res[key] = *c
+	}
+	return res
+}
+
+// Restore loads persisted local counts
+func (s *Stats) Restore(counts map[string]Counts) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, c := range counts {
+		c := c
+		s.local[key] = &c
+	}
+}
+
+// MergePeer replaces the counts gossiped by peer
+func (s *Stats) MergePeer(peer string, counts map[string]Counts) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.peers[peer] = &peerStats{
+		received: time.Now(),
+		counts:   counts,
+	}
+}
+
+// MergePeerChunk collects the chunks of a gossip round of peer, the counts of peer are replaced once every chunk
+// of the round has arrived. A newer round drops an incomplete older one, chunks of older rounds are ignored
+func (s *Stats) MergePeerChunk(peer string, round int64, chunk int, chunks int, counts map[string]Counts) {
+	if chunks <= 1 {
+		s.MergePeer(peer, counts)
+		return
+	}
+	if chunk < 0 || chunk >= chunks {
+		return
+	}
+	s.mu.Lock()
+	pending, ok := s.rounds[peer]
+	if ok && round < pending.round {
+		s.mu.Unlock()
+		return
+	}
+	if !ok || round > pending.round {
+		pending = &peerRound{
+			round:    round,
+			received: make(map[int]bool),
+			counts:   make(map[string]Counts),
+		}
+		s.rounds[peer] = pending
+	}
+	if !pending.received[chunk] {
+		pending.received[chunk] = true
+		for key, c := range counts {
+			pending.counts[key] = c
+		}
+	}
+	complete := len(pending.received) == chunks
+	if complete {
+		delete(s.rounds, peer)
+	}
+	s.mu.Unlock()
+	if complete {
+		s.MergePeer(peer, pending.counts)
+	}
+}
+
+// Trim drops decayed keys and keeps the maxKeys most active keys, so the memory stays bounded
+func (s *Stats) Trim() {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, c := range s.local {
+		s.decay(c, now.UnixMilli())
+		if c.Gets+c.Puts < minWeight {
+			delete(s.local, key)
+		}
+	}
+	for name, peer := range s.peers {
+		if s.peerTTL > 0 && now.Sub(peer.received) > s.peerTTL {
+			delete(s.peers, name)
+		}
+	}
+	if s.maxKeys <= 0 || len(s.local) <= s.maxKeys {
+		return
+	}
+	keys := make([]string, 0, len(s.local))
+	for key := range s.local {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return s.local[keys[i]].Gets+s.local[keys[i]].Puts > s.local[keys[j]].Gets+s.local[keys[j]].Puts
+	})
+	for _, key := range keys[s.maxKeys:] {
+		delete(s.local, key)
+	}
+}
@@ -0,0 +1,71 @@
+package writeless
+
+/*
+	访问轨迹(trace)，格式与 dataset/btcusd_low.csv 一致:
+	read_time,write_counts,period
+	每一行表示：在 read_time 之前的 period 毫秒内发生了 write_counts 次写，随后发生一次读
+*/
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// TraceHeader is the header row shared by every writeless trace file
+var TraceHeader = []string{"read_time", "write_counts", "period"}
+
+// One row of a writeless trace: write_counts puts during period, then one get at read_time
+type TraceRow struct {
+	ReadTime    float64
+	WriteCounts int
+	Period      float64
+}
+
+// LoadTrace reads a whole trace file, limit <= 0 means all rows
+func LoadTrace(filepath string, limit int) ([]TraceRow, error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("trace %s is empty", filepath)
+	}
+	// skip header
+	rows = rows[1:]
+	if limit > 0 && len(rows) > limit {
+		rows = rows[:limit]
+	}
+	trace := make([]TraceRow, 0, len(rows))
+	for i, row := range rows {
+		if len(row) < 3 {
+			return nil, fmt.Errorf("trace %s line %d: want 3 columns, got %d", filepath, i+2, len(row))
+		}
+		readTime, err := strconv.ParseFloat(row[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("trace %s line %d: %v", filepath, i+2, err)
+		}
+		writeCounts, err := strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("trace %s line %d: %v", filepath, i+2, err)
+		}
+		period, err := strconv.ParseFloat(row[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("trace %s line %d: %v", filepath, i+2, err)
+		}
+		trace = append(trace, TraceRow{
+			ReadTime:    readTime,
+			WriteCounts: int(writeCounts),
+			Period:      period,
+		})
+	}
+	return trace, nil
+}